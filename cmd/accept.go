@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 추천받은 식당에 다녀왔음을 방문 기록에 남김
+// 식당 이름을 생략하면 오늘 마지막으로 추천받은 식당을 사용한다.
+func Accept(args []string) error {
+	fs := flag.NewFlagSet("go", flag.ContinueOnError)
+	menus := fs.String("menus", "", "주문한 메뉴 (쉼표로 구분)")
+	spend := fs.Int("spend", -1, "지출 금액 (원)")
+	rating := fs.Float64("rating", -1, "이번 방문 평점 (0~5, 0.5 단위)")
+	yes := fs.Bool("y", false, "추가 질문 없이 기록")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return fmt.Errorf("식당 이름은 하나만 지정할 수 있습니다: %v", positional)
+	}
+	name := ""
+	if len(positional) == 1 {
+		name = positional[0]
+	}
+
+	visit := restaurant.Visit{Menus: splitList(*menus)}
+	if *spend >= 0 {
+		visit.Spend = *spend
+	}
+	if *rating >= 0 {
+		visit.Rating = *rating
+	}
+
+	// 플래그로 주지 않은 항목만 물어봄. 빈 입력은 건너뛴다.
+	if !*yes {
+		in := bufio.NewScanner(os.Stdin)
+		if *menus == "" {
+			visit.Menus = splitList(ask(in, "주문한 메뉴 (쉼표로 구분, 생략 가능): "))
+		}
+		if *spend < 0 {
+			if answer := ask(in, "지출 금액 (원, 생략 가능): "); answer != "" {
+				n, err := strconv.Atoi(answer)
+				if err != nil {
+					return fmt.Errorf("지출 금액은 정수여야 합니다: %s", answer)
+				}
+				visit.Spend = n
+			}
+		}
+		if *rating < 0 {
+			if answer := ask(in, "평점 (0~5, 0.5 단위, 생략 가능): "); answer != "" {
+				n, err := strconv.ParseFloat(answer, 64)
+				if err != nil {
+					return fmt.Errorf("평점은 숫자여야 합니다: %s", answer)
+				}
+				visit.Rating = n
+			}
+		}
+	}
+
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	saved, err := service.Accept(name, visit)
+	if err != nil {
+		return fmt.Errorf("방문 기록 실패: %w", err)
+	}
+	fmt.Printf("%s 방문을 기록했습니다. (%s)\n", saved.Restaurant, saved.Date)
+	return nil
+}
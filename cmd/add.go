@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/arch-spatula/jmc/internal/kakao"
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 식당을 추가함
+//
+//	jmc add <이름> [--category 한식,국밥] [--loc 강남역]
+//	jmc add --kakao <카카오맵 URL|검색어> [이름] [--loc 강남역] [-y]
+//
+// --kakao로 장소를 주면 카카오 로컬 API로 이름, 카테고리, 주소, 좌표를 채운다.
+// 검색어면 첫 번째 기준 위치에서 가까운 순서로 검색해 고른다.
+func Add(args []string) error {
+	fs := flag.NewFlagSet("add", flag.ContinueOnError)
+	kakaoArg := fs.String("kakao", "", "카카오맵 장소 URL, 장소 ID 또는 검색어")
+	categories := fs.String("category", "", "카테고리 (쉼표로 구분). 주면 카카오맵 분류 대신 쓴다")
+	locations := fs.String("loc", "", "위치 (쉼표로 구분)")
+	yes := fs.Bool("y", false, "검색 결과가 여럿이면 묻지 않고 첫 번째를 고름")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return fmt.Errorf("식당 이름은 하나만 지정할 수 있습니다. 공백이 있으면 따옴표로 감싸주세요: %v", positional)
+	}
+
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	item := restaurant.Restaurant{Categories: splitList(*categories), Locations: splitList(*locations)}
+	if *kakaoArg != "" {
+		data, err := service.GetAll()
+		if err != nil {
+			return err
+		}
+		place, err := findKakaoPlace(kakaoClient(&data.CLIConfig), &data.CLIConfig, *kakaoArg, *yes)
+		if errors.Is(err, kakao.ErrNoAPIKey) {
+			return fmt.Errorf("%w. KAKAO_REST_API_KEY 환경 변수나 cli_config.kakao_api_key에 REST API 키를 넣어주세요", err)
+		}
+		if err != nil {
+			return fmt.Errorf("카카오맵 장소 조회 실패: %w", err)
+		}
+		item.Name = place.Name
+		if len(item.Categories) == 0 {
+			item.Categories = place.Categories()
+		}
+		item.Address = place.FullAddress()
+		item.KakaoURL = kakao.PlaceURL(place.ID)
+		if p, ok := place.Point(); ok {
+			item.Coord = &p
+		}
+	}
+	if len(positional) == 1 {
+		item.Name = positional[0]
+	}
+	if item.Name == "" {
+		return fmt.Errorf("사용법: jmc add <이름> [--category 카테고리] [--loc 위치] | jmc add --kakao <URL|검색어>")
+	}
+
+	saved, err := service.Add(item)
+	if err != nil {
+		return fmt.Errorf("식당 추가 실패: %w", err)
+	}
+	fmt.Printf("%s을(를) 추가했습니다.\n", saved.Name)
+	if len(saved.Categories) > 0 {
+		fmt.Printf("  카테고리 %s\n", strings.Join(saved.Categories, ", "))
+	}
+	if saved.Address != "" {
+		fmt.Printf("  주소 %s\n", saved.Address)
+	}
+	if saved.KakaoURL != "" {
+		fmt.Printf("  카카오맵 %s\n", saved.KakaoURL)
+	}
+	return nil
+}
+
+// 설정과 환경 변수로 만든 카카오 API 클라이언트
+func kakaoClient(cfg *restaurant.CLIConfig) *kakao.Client {
+	key := os.Getenv("KAKAO_REST_API_KEY")
+	if key == "" {
+		key = cfg.KakaoAPIKey
+	}
+	client := kakao.NewClient(key)
+	if cfg.KakaoBaseURL != "" {
+		client.BaseURL = cfg.KakaoBaseURL
+	}
+	if cfg.KakaoPlaceURL != "" {
+		client.PlaceURL = cfg.KakaoPlaceURL
+	}
+	return client
+}
+
+// 장소 URL이면 그 장소를, 아니면 검색 결과에서 고른 장소를 반환함
+func findKakaoPlace(client *kakao.Client, cfg *restaurant.CLIConfig, arg string, yes bool) (*kakao.Place, error) {
+	ctx := context.Background()
+	if id, ok := kakao.PlaceID(arg); ok {
+		return client.Place(ctx, id)
+	}
+
+	opts := kakao.SearchOptions{Size: 5}
+	if len(cfg.Origins) > 0 {
+		opts.Center = &cfg.Origins[0].Point
+	}
+	places, err := client.Search(ctx, arg, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(places) == 0 {
+		return nil, fmt.Errorf("%w: %s", kakao.ErrNotFound, arg)
+	}
+	if len(places) == 1 || yes {
+		return &places[0], nil
+	}
+
+	for i, p := range places {
+		fmt.Printf("%d. %s (%s) %s\n", i+1, p.Name, p.Category, p.FullAddress())
+	}
+	answer := ask(bufio.NewScanner(os.Stdin), fmt.Sprintf("번호를 고르세요 (1-%d, 기본값 1): ", len(places)))
+	if answer == "" {
+		return &places[0], nil
+	}
+	n, err := strconv.Atoi(answer)
+	if err != nil || n < 1 || n > len(places) {
+		return nil, fmt.Errorf("1에서 %d 사이의 번호를 골라주세요: %s", len(places), answer)
+	}
+	return &places[n-1], nil
+}
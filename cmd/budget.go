@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 한 달 식비 현황
+//
+//	jmc budget [YYYY-MM]      이번 달(또는 그 달)의 지출, 예상 지출, 카테고리별/식당별 지출
+//	jmc budget set <금액>      한 달 식대 한도 (0이면 한도 없음)
+func Budget(args []string) error {
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	if len(args) > 0 && args[0] == "set" {
+		if len(args) != 2 {
+			return fmt.Errorf("사용법: jmc budget set <금액>")
+		}
+		limit, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("금액은 숫자여야 합니다: %s", args[1])
+		}
+		if err := service.SetMonthlyBudget(limit); err != nil {
+			return fmt.Errorf("식대 한도 저장 실패: %w", err)
+		}
+		fmt.Printf("한 달 식대 한도를 %s원으로 정했습니다.\n", restaurant.FormatPrice(limit))
+		return nil
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("사용법: jmc budget [YYYY-MM] | jmc budget set <금액>")
+	}
+
+	month := ""
+	if len(args) == 1 {
+		month = args[0]
+	}
+	s, err := service.Spending(month)
+	if err != nil {
+		return fmt.Errorf("식비 조회 실패: %w", err)
+	}
+	printSpending(s)
+	return nil
+}
+
+func printSpending(s *restaurant.Spending) {
+	fmt.Printf("%s 식비 %s원 (방문 %d번, 근무일 %d/%d일)\n", s.Month, restaurant.FormatPrice(s.Total), s.Visits, s.ElapsedWorkdays, s.Workdays)
+	if s.Unrecorded > 0 {
+		fmt.Printf("  지출을 기록하지 않은 방문 %d번\n", s.Unrecorded)
+	}
+	if s.Limit > 0 {
+		fmt.Printf("  한도 %s원, 남은 금액 %s원\n", restaurant.FormatPrice(s.Limit), restaurant.FormatPrice(s.Limit-s.Total))
+	}
+	fmt.Printf("  월말 예상 %s원\n", restaurant.FormatPrice(s.Projected))
+	switch {
+	case s.Limit == 0:
+		fmt.Println("  한도가 없습니다. jmc budget set <금액>으로 정할 수 있습니다.")
+	case s.Total > s.Limit:
+		fmt.Printf("  한도를 %s원 넘었습니다.\n", restaurant.FormatPrice(s.Total-s.Limit))
+	case s.AheadOfPace():
+		fmt.Printf("  이대로면 한도를 넘습니다. 남은 근무일에는 하루 %s원 안에서 드세요. 추천은 싼 식당을 우선합니다.\n", restaurant.FormatPrice(s.DailyAllowance))
+	default:
+		fmt.Printf("  여유 있습니다. 남은 근무일 하루 %s원\n", restaurant.FormatPrice(s.DailyAllowance))
+	}
+
+	if len(s.ByCategory) > 0 {
+		fmt.Println("카테고리별")
+		for _, item := range s.ByCategory {
+			fmt.Printf("  %s %s원 (%d번)\n", item.Name, restaurant.FormatPrice(item.Total), item.Visits)
+		}
+	}
+	if len(s.ByRestaurant) > 0 {
+		fmt.Println("식당별")
+		for _, item := range s.ByRestaurant {
+			fmt.Printf("  %s %s원 (%d번)\n", item.Name, restaurant.FormatPrice(item.Total), item.Visits)
+		}
+	}
+}
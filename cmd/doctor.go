@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/arch-spatula/jmc/internal/linkcheck"
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// data.json 점검
+//
+//	jmc doctor links [--workers 4] [--timeout 10s] [--retries 2] [--all] [-y]
+//	                                      카카오맵 링크를 열어 없어진 장소와 폐업한 장소를 찾고 보관함
+//	jmc doctor unarchive <식당>...        보관한 식당을 다시 추천에 넣음
+func Doctor(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("사용법: jmc doctor links|unarchive")
+	}
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	switch args[0] {
+	case "links":
+		return checkLinks(service, args[1:])
+	case "unarchive":
+		if len(args) < 2 {
+			return fmt.Errorf("사용법: jmc doctor unarchive <식당>...")
+		}
+		if err := service.SetArchived(args[1:], false); err != nil {
+			return fmt.Errorf("보관 해제 실패: %w", err)
+		}
+		fmt.Printf("%s을(를) 다시 추천합니다.\n", strings.Join(args[1:], ", "))
+		return nil
+	default:
+		return fmt.Errorf("알 수 없는 doctor 명령어: %s", args[0])
+	}
+}
+
+func checkLinks(service *restaurant.Service, args []string) error {
+	checker := linkcheck.NewChecker()
+	fs := flag.NewFlagSet("doctor links", flag.ContinueOnError)
+	fs.IntVar(&checker.Workers, "workers", checker.Workers, "동시에 여는 요청 수")
+	fs.DurationVar(&checker.Timeout, "timeout", checker.Timeout, "요청 한 번의 제한 시간")
+	fs.IntVar(&checker.Retries, "retries", checker.Retries, "네트워크 오류나 5xx 응답일 때 다시 시도하는 횟수")
+	all := fs.Bool("all", false, "보관한 식당의 링크도 확인")
+	yes := fs.Bool("y", false, "묻지 않고 죽은 링크의 식당을 보관")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if checker.Workers < 1 || checker.Timeout <= 0 || checker.Retries < 0 {
+		return fmt.Errorf("--workers는 1 이상, --timeout은 0보다 커야 하고 --retries는 음수일 수 없습니다")
+	}
+
+	data, err := service.GetAll()
+	if err != nil {
+		return err
+	}
+	names, urls := []string{}, []string{}
+	for _, rest := range data.Restaurants {
+		if rest.KakaoURL != "" && (*all || !rest.Archived) {
+			names = append(names, rest.Name)
+			urls = append(urls, rest.KakaoURL)
+		}
+	}
+	if len(urls) == 0 {
+		fmt.Println("확인할 카카오맵 링크가 없습니다.")
+		return nil
+	}
+
+	fmt.Printf("카카오맵 링크 %d개를 확인합니다...\n", len(urls))
+	start := time.Now()
+	results := checker.CheckAll(context.Background(), urls)
+
+	broken, failed := []string{}, 0
+	for i, r := range results {
+		switch {
+		case r.Broken():
+			broken = append(broken, names[i])
+			fmt.Printf("  %s: %s (%s)\n", names[i], r.Status, linkDetail(r))
+		case r.Status == linkcheck.StatusError:
+			failed++
+			fmt.Printf("  %s: 확인 실패 %s (%d번 시도): %v\n", names[i], r.URL, r.Attempts, r.Err)
+		}
+	}
+	fmt.Printf("정상 %d개, 죽은 링크 %d개, 확인 실패 %d개 (%s)\n",
+		len(results)-len(broken)-failed, len(broken), failed, time.Since(start).Round(100*time.Millisecond))
+	if len(broken) == 0 {
+		return nil
+	}
+
+	if !*yes {
+		answer := ask(bufio.NewScanner(os.Stdin), fmt.Sprintf("%d곳을 보관할까요? 보관한 식당은 추천하지 않습니다 (y/N): ", len(broken)))
+		if !strings.EqualFold(answer, "y") {
+			return nil
+		}
+	}
+	if err := service.SetArchived(broken, true); err != nil {
+		return fmt.Errorf("식당 보관 실패: %w", err)
+	}
+	fmt.Printf("%s을(를) 보관했습니다. 다시 추천하려면 jmc doctor unarchive <식당>\n", strings.Join(broken, ", "))
+	return nil
+}
+
+// 죽은 링크의 상태 코드와 리다이렉트된 주소
+func linkDetail(r linkcheck.Result) string {
+	if r.FinalURL != "" && r.FinalURL != r.URL {
+		return fmt.Sprintf("%d, %s → %s", r.StatusCode, r.URL, r.FinalURL)
+	}
+	return fmt.Sprintf("%d, %s", r.StatusCode, r.URL)
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 식당 목록을 다른 형식으로 내보냄
+//
+//	jmc export csv [파일] [--menus 메뉴.csv]
+//
+// 파일을 생략하면 표준 출력에 쓴다. --menus를 주면 메뉴를 그 파일에 따로 쓰고, 아니면 메뉴마다 한 행으로 펼친다.
+func Export(args []string) error {
+	if len(args) == 0 || args[0] != "csv" {
+		return fmt.Errorf("사용법: jmc export csv [파일] [--menus 메뉴.csv]")
+	}
+	fs := flag.NewFlagSet("export csv", flag.ContinueOnError)
+	menusFile := fs.String("menus", "", "메뉴를 따로 쓸 CSV 파일 (생략하면 메뉴마다 한 행으로 펼침)")
+	positional, err := parseArgs(fs, args[1:])
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return fmt.Errorf("내보낼 파일은 하나만 지정할 수 있습니다: %v", positional)
+	}
+
+	var out, menus bytes.Buffer
+	var menusOut io.Writer
+	if *menusFile != "" {
+		menusOut = &menus
+	}
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	if err := service.ExportCSV(&out, menusOut); err != nil {
+		return fmt.Errorf("내보내기 실패: %w", err)
+	}
+
+	if *menusFile != "" {
+		if err := os.WriteFile(*menusFile, menus.Bytes(), 0644); err != nil {
+			return fmt.Errorf("메뉴 파일 저장 실패: %w", err)
+		}
+	}
+	if len(positional) == 0 {
+		if _, err := os.Stdout.Write(out.Bytes()); err != nil {
+			return fmt.Errorf("출력 실패: %w", err)
+		}
+		return nil
+	}
+	if err := os.WriteFile(positional[0], out.Bytes(), 0644); err != nil {
+		return fmt.Errorf("CSV 파일 저장 실패: %w", err)
+	}
+	fmt.Printf("%s에 내보냈습니다.\n", positional[0])
+	return nil
+}
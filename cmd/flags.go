@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// 플래그와 위치 인자가 섞여 있어도 모두 파싱함
+// 예: jmc go 김밥천국 --spend 9000
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// 질문을 출력하고 한 줄을 입력받음. 입력이 끝났으면 빈 문자열을 반환한다.
+func ask(in *bufio.Scanner, question string) string {
+	fmt.Print(question)
+	if !in.Scan() {
+		fmt.Println()
+		return ""
+	}
+	return strings.TrimSpace(in.Text())
+}
+
+// 쉼표로 구분된 값을 나눔
+func splitList(s string) []string {
+	list := []string{}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			list = append(list, part)
+		}
+	}
+	return list
+}
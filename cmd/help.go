@@ -4,8 +4,25 @@ import "fmt"
 
 // 도움말을 출력함
 func Help() {
-	fmt.Println("Usage: jmc <command>")
+	fmt.Println("Usage: jmc [-n 개수] [--mode 모드] [--explain] [--at HH:MM] [--max-walk 10m] [--from 위치] [--region 지역] [-q 검색식] [--budget 예산] [--for 프로필] [--seed 시드] | jmc <command>")
 	fmt.Println("Commands:")
+	fmt.Println("  today - 팀원 모두에게 같은 오늘의 추천")
+	fmt.Println("  add <이름> | add --kakao <URL|검색어> - 식당 추가 (카카오맵에서 이름, 카테고리, 주소, 좌표를 채움)")
+	fmt.Println("  list [--max-walk 10m] [--from 위치] [--region 지역] [-q 검색식] - 식당 목록 (가까운 순)")
+	fmt.Println("  search [-n 개수] <검색어> - 식당 검색 (초성, 오타, 로마자 지원)")
+	fmt.Println("  tags [rename|merge] - 카테고리 태그 목록, 이름 바꾸기, 합치기")
+	fmt.Println("  menu list|add|edit|rm|pick|history <식당> [메뉴] - 식당의 메뉴 관리, 주문할 메뉴 추천, 가격 기록")
+	fmt.Println("  -r, reroll - 직전 추천을 거절하고 다시 추천 (오늘 하루 제외)")
+	fmt.Println("  go, accept [식당] - 추천받은 식당 방문 기록")
+	fmt.Println("  plan [new|reroll|set|ics] - 주간 점심 계획")
+	fmt.Println("  budget [YYYY-MM|set 금액] - 한 달 식비 현황과 식대 한도")
+	fmt.Println("  split [add|pay] - 같이 먹은 점심의 더치페이 계산과 정산")
+	fmt.Println("  doctor links|unarchive - 카카오맵 링크를 확인해 폐업한 식당을 보관, 보관 해제")
+	fmt.Println("  profile [set|rm|default] - 채식, 알레르기 같은 식단 프로필 (추천이 반드시 지킴)")
+	fmt.Println("  import csv <파일> [--map 매핑] [--menus 메뉴.csv] [--dry-run] - CSV에서 식당 가져오기")
+	fmt.Println("  export csv [파일] [--menus 메뉴.csv] - 식당 목록을 CSV로 내보내기")
+	fmt.Println("  holiday [연도] - 공휴일 목록 (오프라인 표)")
+	fmt.Println("  simulate - 방문 기록을 재생해 추천 전략 비교")
 	fmt.Println("  -i, init - 초기화 출력")
 	fmt.Println("  -h, help - 도움말 출력")
 	fmt.Println("  -w, wiki - 위키 출력")
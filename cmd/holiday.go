@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/arch-spatula/jmc/internal/holiday"
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 그 해의 공휴일 목록을 출력함 (기본값: 올해)
+func Holiday(args []string) error {
+	year := time.Now().Year()
+	if len(args) > 0 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("연도는 숫자여야 합니다: %s", args[0])
+		}
+		year = n
+	}
+
+	list, err := holiday.InYear(year)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d년 공휴일 (표 버전 %s)\n", year, holiday.Version)
+	for _, h := range list {
+		date, err := time.Parse("2006-01-02", h.Date)
+		if err != nil {
+			return fmt.Errorf("공휴일 표의 날짜가 잘못되었습니다: %s", h.Date)
+		}
+		fmt.Printf("  %s (%s) %s\n", h.Date, restaurant.WeekdayName(date.Weekday()), h.Name)
+	}
+	return nil
+}
+
+// 오늘이 공휴일이면 알려줌
+func printTodayHoliday() {
+	if h, ok := holiday.Lookup(time.Now()); ok {
+		fmt.Printf("오늘은 공휴일입니다: %s\n", h.Name)
+	}
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 다른 형식의 식당 목록을 가져옴
+//
+//	jmc import csv <파일> [--map "상호=name,분류=categories"] [--mapping 매핑.json] [--menus 메뉴.csv]
+//	                      [--sep ",;"] [--dry-run] [--update] [--skip-errors]
+//
+// 매핑 파일은 {"상호": "name", "비고": "-"} 같은 JSON 객체다. --map이 매핑 파일보다 우선한다.
+func Import(args []string) error {
+	if len(args) == 0 || args[0] != "csv" {
+		return fmt.Errorf("사용법: jmc import csv <파일> [--map 매핑] [--mapping 매핑.json] [--menus 메뉴.csv] [--dry-run]")
+	}
+	fs := flag.NewFlagSet("import csv", flag.ContinueOnError)
+	mapFlag := fs.String("map", "", "머리글=필드 (쉼표로 구분, 무시할 열은 머리글=-). 필드: "+strings.Join(restaurant.CSVFields, ", "))
+	mappingFile := fs.String("mapping", "", "머리글과 필드의 대응을 담은 JSON 파일")
+	menusFile := fs.String("menus", "", "메뉴만 따로 담은 CSV 파일 (restaurant, menu, menu_price ... 열)")
+	sep := fs.String("sep", ",;", "카테고리, 위치의 여러 값을 나누는 문자들")
+	dryRun := fs.Bool("dry-run", false, "저장하지 않고 가져올 내용만 미리 봄")
+	update := fs.Bool("update", false, "이미 있는 식당을 CSV 내용으로 바꿈 (기본값은 건너뜀)")
+	skipErrors := fs.Bool("skip-errors", false, "오류가 있는 식당만 빼고 가져옴 (기본값은 오류가 있으면 저장하지 않음)")
+	positional, err := parseArgs(fs, args[1:])
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("가져올 CSV 파일을 하나 지정해주세요")
+	}
+
+	opts := restaurant.ImportOptions{Mapping: map[string]string{}, Separators: *sep, DryRun: *dryRun, Update: *update, SkipErrors: *skipErrors}
+	if *mappingFile != "" {
+		b, err := os.ReadFile(*mappingFile)
+		if err != nil {
+			return fmt.Errorf("매핑 파일 읽기 실패: %w", err)
+		}
+		if err := json.Unmarshal(b, &opts.Mapping); err != nil {
+			return fmt.Errorf("매핑 파일은 {\"머리글\": \"필드\"} 형식의 JSON이어야 합니다: %w", err)
+		}
+	}
+	mapping, err := restaurant.ParseCSVMapping(*mapFlag)
+	if err != nil {
+		return err
+	}
+	for header, field := range mapping {
+		opts.Mapping[header] = field
+	}
+
+	in, err := os.ReadFile(positional[0])
+	if err != nil {
+		return fmt.Errorf("CSV 파일 읽기 실패: %w", err)
+	}
+	var menus io.Reader
+	if *menusFile != "" {
+		b, err := os.ReadFile(*menusFile)
+		if err != nil {
+			return fmt.Errorf("메뉴 파일 읽기 실패: %w", err)
+		}
+		menus = bytes.NewReader(b)
+	}
+
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	result, err := service.ImportCSV(bytes.NewReader(in), menus, opts)
+	if err != nil {
+		return fmt.Errorf("가져오기 실패: %w", err)
+	}
+	printImport(result, opts)
+	if len(result.Errors) > 0 && !opts.DryRun && !opts.SkipErrors {
+		return fmt.Errorf("오류가 %d건 있어 저장하지 않았습니다. 고친 뒤 다시 실행하거나 --skip-errors를 주세요", len(result.Errors))
+	}
+	return nil
+}
+
+func printImport(result *restaurant.ImportResult, opts restaurant.ImportOptions) {
+	fmt.Printf("새 식당 %d곳, 바꿀 식당 %d곳, 건너뛸 식당 %d곳, 오류 %d건\n", len(result.New), len(result.Updated), len(result.Skipped), len(result.Errors))
+	for _, r := range result.New {
+		fmt.Printf("  + %s\n", importSummary(r))
+	}
+	for _, r := range result.Updated {
+		fmt.Printf("  ~ %s\n", importSummary(r))
+	}
+	for _, name := range result.Skipped {
+		fmt.Printf("  = %s (이미 있음, --update로 바꿀 수 있음)\n", name)
+	}
+	for _, e := range result.Errors {
+		fmt.Printf("  ! %s\n", e.Error())
+	}
+	if len(result.Ignored) > 0 {
+		fmt.Printf("무시한 열: %s\n", strings.Join(result.Ignored, ", "))
+	}
+	switch {
+	case opts.DryRun:
+		fmt.Println("미리보기만 했습니다. --dry-run 없이 실행하면 저장합니다.")
+	case result.Saved:
+		fmt.Println("저장했습니다.")
+	}
+}
+
+func importSummary(r restaurant.Restaurant) string {
+	line := r.Name
+	if len(r.Categories) > 0 || len(r.Locations) > 0 {
+		line += fmt.Sprintf(" (%s / %s)", strings.Join(r.Categories, ", "), strings.Join(r.Locations, ", "))
+	}
+	if len(r.Menus) > 0 {
+		line += fmt.Sprintf(" 메뉴 %d개", len(r.Menus))
+	}
+	return line
+}
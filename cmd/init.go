@@ -23,6 +23,9 @@ func Init() {
 				Filters:  []restaurant.SearchFilter{},
 				Selected: nil,
 			},
+			Visits:     []restaurant.Visit{},
+			Regions:    []restaurant.Region{},
+			Categories: []restaurant.Category{},
 		}
 		if err := repo.Save(&data); err != nil {
 			fmt.Fprintf(os.Stderr, "data.json 파일을 생성할 수 없습니다: %v\n", err)
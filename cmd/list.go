@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 식당 목록을 출발 위치에서 가까운 순서로 출력함
+func List(args []string) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	from := fs.String("from", "", "출발 위치 (cli_config.origins의 이름, 기본값은 첫 번째)")
+	maxWalk := walkFlag(fs)
+	region := fs.String("region", "", "지역 (하위 지역 포함, 예: 강남구)")
+	query := queryFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	list, err := service.List(restaurant.ListOptions{From: *from, MaxWalk: *maxWalk, Region: *region, Query: *query})
+	if err != nil {
+		return fmt.Errorf("식당 목록 조회 실패: %w%s", err, queryCaret(err))
+	}
+	if len(list) == 0 {
+		fmt.Println("조건에 맞는 식당이 없습니다.")
+		return nil
+	}
+	for _, item := range list {
+		walk, prices := "-", "-"
+		if item.Walk != nil {
+			walk = item.Walk.String()
+		}
+		if item.Prices != nil {
+			prices = item.Prices.String()
+		}
+		fmt.Printf("%-16s %s %s\n", walk, formatListing(item.Restaurant), prices)
+	}
+	return nil
+}
+
+func formatListing(r restaurant.Restaurant) string {
+	if r.Archived {
+		return fmt.Sprintf("%s %.1f %s (보관됨)", r.Name, r.Rating, r.Categories)
+	}
+	return fmt.Sprintf("%s %.1f %s", r.Name, r.Rating, r.Categories)
+}
@@ -0,0 +1,275 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 식당의 메뉴 관리
+//
+//	jmc menu list <식당>                                          메뉴 목록
+//	jmc menu add <식당> <메뉴> [--price 가격] [--rating 평점] [--desc 설명] [--diet 식단]
+//	jmc menu edit <식당> <메뉴> [--name 새 이름] [--price 가격] [--rating 평점] [--desc 설명] [--visited] [--diet 식단]
+//	jmc menu rm <식당> <메뉴>
+//	jmc menu pick <식당> [--mode usual|new] [--budget 예산] [-n 개수] [--for 프로필]   주문할 메뉴 추천
+//	jmc menu history [식당] [메뉴]                               가격 기록. 식당이 없으면 최근에 많이 오른 메뉴
+func Menu(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("사용법: jmc menu list|add|edit|rm|pick|history <식당> [메뉴]")
+	}
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+
+	switch args[0] {
+	case "list", "ls":
+		if len(args) != 2 {
+			return fmt.Errorf("사용법: jmc menu list <식당>")
+		}
+		return listMenus(service, args[1])
+	case "add":
+		return addMenu(service, args[1:])
+	case "edit":
+		return editMenu(service, args[1:])
+	case "pick":
+		return pickMenu(service, args[1:])
+	case "history":
+		return menuHistory(service, args[1:])
+	case "rm":
+		if len(args) != 3 {
+			return fmt.Errorf("사용법: jmc menu rm <식당> <메뉴>")
+		}
+		if err := service.DeleteMenu(args[1], args[2]); err != nil {
+			return fmt.Errorf("메뉴 삭제 실패: %w", err)
+		}
+		fmt.Printf("%s에서 %s를 삭제했습니다.\n", args[1], args[2])
+		return nil
+	default:
+		return fmt.Errorf("알 수 없는 menu 명령어: %s", args[0])
+	}
+}
+
+func listMenus(service *restaurant.Service, name string) error {
+	menus, err := service.Menus(name)
+	if err != nil {
+		return fmt.Errorf("메뉴 조회 실패: %w", err)
+	}
+	if len(menus) == 0 {
+		fmt.Println("등록된 메뉴가 없습니다.")
+		return nil
+	}
+	for _, m := range menus {
+		fmt.Println(formatMenu(m))
+	}
+	return nil
+}
+
+func formatMenu(m restaurant.Menu) string {
+	line := fmt.Sprintf("%s %s원 %.1f", m.Name, restaurant.FormatPrice(m.Price), m.Rating)
+	if m.Visited {
+		line += " (먹어 봄)"
+	}
+	if m.Diet != nil {
+		line += " [" + m.Diet.String() + "]"
+	}
+	if m.Description != "" {
+		line += " - " + m.Description
+	}
+	return line
+}
+
+// 메뉴 필드 플래그
+type menuFlags struct {
+	fs          *flag.FlagSet
+	name        *string
+	price       *int
+	rating      *float64
+	description *string
+	visited     *bool
+	diet        *restaurant.Diet
+}
+
+func newMenuFlags(command string) *menuFlags {
+	fs := flag.NewFlagSet(command, flag.ContinueOnError)
+	f := &menuFlags{
+		fs:          fs,
+		name:        fs.String("name", "", "새 메뉴 이름"),
+		price:       fs.Int("price", 0, "가격 (원)"),
+		rating:      fs.Float64("rating", 0, "평점 (0~5, 0.5 단위)"),
+		description: fs.String("desc", "", "설명"),
+		visited:     fs.Bool("visited", false, "먹어 본 메뉴"),
+	}
+	fs.Func("diet", "식단 정보 (예: \"채식, 맵기=2, 땅콩\")", func(v string) error {
+		diet, err := restaurant.ParseDiet(v)
+		if err != nil {
+			return err
+		}
+		f.diet = diet
+		return nil
+	})
+	return f
+}
+
+// 명령줄에 준 플래그만 담은 변경 요청
+func (f *menuFlags) patch() restaurant.MenuPatch {
+	var p restaurant.MenuPatch
+	f.fs.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "name":
+			p.Name = f.name
+		case "price":
+			p.Price = f.price
+		case "rating":
+			p.Rating = f.rating
+		case "desc":
+			p.Description = f.description
+		case "visited":
+			p.Visited = f.visited
+		case "diet":
+			p.Diet = f.diet
+		}
+	})
+	return p
+}
+
+func addMenu(service *restaurant.Service, args []string) error {
+	flags := newMenuFlags("menu add")
+	rest, err := parseArgs(flags.fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 2 {
+		return fmt.Errorf("사용법: jmc menu add <식당> <메뉴> [--price 가격] [--rating 평점] [--desc 설명] [--diet 식단]")
+	}
+	menu := restaurant.Menu{
+		Name:        rest[1],
+		Price:       *flags.price,
+		Rating:      *flags.rating,
+		Description: *flags.description,
+		Visited:     *flags.visited,
+		Diet:        flags.diet,
+	}
+	added, err := service.AddMenu(rest[0], menu)
+	if err != nil {
+		return fmt.Errorf("메뉴 추가 실패: %w", err)
+	}
+	fmt.Printf("%s에 메뉴를 추가했습니다: %s\n", rest[0], formatMenu(*added))
+	return nil
+}
+
+func editMenu(service *restaurant.Service, args []string) error {
+	flags := newMenuFlags("menu edit")
+	rest, err := parseArgs(flags.fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 2 {
+		return fmt.Errorf("사용법: jmc menu edit <식당> <메뉴> [--name 새 이름] [--price 가격] [--rating 평점] [--desc 설명] [--visited] [--diet 식단]")
+	}
+	patch := flags.patch()
+	if patch == (restaurant.MenuPatch{}) {
+		return fmt.Errorf("바꿀 필드가 없습니다. --price 같은 플래그를 주세요")
+	}
+	updated, err := service.UpdateMenu(rest[0], rest[1], patch)
+	if err != nil {
+		return fmt.Errorf("메뉴 수정 실패: %w", err)
+	}
+	fmt.Printf("%s의 메뉴를 수정했습니다: %s\n", rest[0], formatMenu(*updated))
+	return nil
+}
+
+func pickMenu(service *restaurant.Service, args []string) error {
+	fs := flag.NewFlagSet("menu pick", flag.ContinueOnError)
+	mode := fs.String("mode", "", "usual: 늘 먹던 메뉴, new: 안 먹어 본 메뉴 (기본값은 둘을 고루)")
+	budget := fs.Int("budget", 0, "예산 (원). 이보다 비싼 메뉴는 빼고 추천")
+	count := fs.Int("n", 1, "추천받을 메뉴 수")
+	profiles := profilesFlag(fs)
+	rest, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 1 {
+		return fmt.Errorf("사용법: jmc menu pick <식당> [--mode usual|new] [--budget 예산] [-n 개수] [--for 프로필]")
+	}
+	picks, err := service.PickMenu(rest[0], restaurant.OrderOptions{Mode: *mode, Budget: *budget, Count: *count, Profiles: splitList(*profiles)})
+	if err != nil {
+		return fmt.Errorf("메뉴 추천 실패: %w", err)
+	}
+	if len(picks) == 0 {
+		fmt.Println("추천할 메뉴가 없습니다.")
+		return nil
+	}
+	for _, p := range picks {
+		fmt.Println(formatPick(p))
+	}
+	return nil
+}
+
+func formatPick(p restaurant.MenuPick) string {
+	if p.Price > 0 {
+		return fmt.Sprintf("%s %s원 (%s)", p.Name, restaurant.FormatPrice(p.Price), p.Reason)
+	}
+	return fmt.Sprintf("%s (%s)", p.Name, p.Reason)
+}
+
+func menuHistory(service *restaurant.Service, args []string) error {
+	fs := flag.NewFlagSet("menu history", flag.ContinueOnError)
+	days := fs.Int("days", 90, "가격이 오른 메뉴를 찾을 기간 (일)")
+	limit := fs.Int("n", 10, "보여줄 메뉴 수 (0이면 모두)")
+	rest, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	switch len(rest) {
+	case 0:
+		return printPriceIncreases(service, *days, *limit)
+	case 1:
+		menus, err := service.Menus(rest[0])
+		if err != nil {
+			return fmt.Errorf("메뉴 조회 실패: %w", err)
+		}
+		for _, m := range menus {
+			if err := printPriceHistory(service, rest[0], m.Name); err != nil {
+				return err
+			}
+		}
+		return nil
+	case 2:
+		return printPriceHistory(service, rest[0], rest[1])
+	default:
+		return fmt.Errorf("사용법: jmc menu history [식당] [메뉴] [--days 기간] [-n 개수]")
+	}
+}
+
+func printPriceHistory(service *restaurant.Service, name, menu string) error {
+	history, err := service.MenuPrices(name, menu)
+	if err != nil {
+		return fmt.Errorf("가격 기록 조회 실패: %w", err)
+	}
+	fmt.Printf("%s %s원\n", history.Menu, restaurant.FormatPrice(history.Price))
+	if len(history.History) == 0 {
+		fmt.Println("  가격이 바뀐 적이 없습니다.")
+	}
+	for _, c := range history.History {
+		fmt.Printf("  %s %s원 → %s원 (%+.1f%%)\n", c.Date, restaurant.FormatPrice(c.From), restaurant.FormatPrice(c.To), c.Rate()*100)
+	}
+	return nil
+}
+
+func printPriceIncreases(service *restaurant.Service, days, limit int) error {
+	increases, err := service.PriceIncreases(days, limit)
+	if err != nil {
+		return fmt.Errorf("가격 기록 조회 실패: %w", err)
+	}
+	if len(increases) == 0 {
+		fmt.Printf("최근 %d일 동안 가격이 오른 메뉴가 없습니다.\n", days)
+		return nil
+	}
+	fmt.Printf("최근 %d일 동안 가격이 오른 메뉴\n", days)
+	for _, inc := range increases {
+		fmt.Printf("%+6.1f%% %s %s %s원 → %s원 (%s)\n", inc.Rate*100, inc.Restaurant, inc.Menu,
+			restaurant.FormatPrice(inc.From), restaurant.FormatPrice(inc.To), inc.Date)
+	}
+	return nil
+}
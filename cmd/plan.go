@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 주간 점심 계획
+//
+//	jmc plan                       저장된 계획 출력
+//	jmc plan new [플래그]           이번 주(또는 --week) 계획 생성
+//	jmc plan reroll <요일|날짜>      하루만 다시 뽑기
+//	jmc plan set <요일|날짜> <normal|team|skip>
+//	jmc plan ics [파일]             ICS로 내보내기 (파일이 없으면 stdout)
+func Plan(args []string) error {
+	if len(args) == 0 {
+		return showPlan()
+	}
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+
+	switch args[0] {
+	case "show":
+		return showPlan()
+	case "new":
+		return newPlan(service, args[1:])
+	case "reroll":
+		if len(args) != 2 {
+			return fmt.Errorf("사용법: jmc plan reroll <요일|날짜>")
+		}
+		plan, err := service.RerollPlanDay(args[1], nil)
+		if err != nil {
+			return fmt.Errorf("다시 뽑기 실패: %w", err)
+		}
+		printPlan(plan)
+		return nil
+	case "set":
+		if len(args) != 3 {
+			return fmt.Errorf("사용법: jmc plan set <요일|날짜> <normal|team|skip>")
+		}
+		kind := args[2]
+		if kind == "normal" {
+			kind = restaurant.PlanNormal
+		}
+		plan, err := service.RerollPlanDay(args[1], &kind)
+		if err != nil {
+			return fmt.Errorf("계획 변경 실패: %w", err)
+		}
+		printPlan(plan)
+		return nil
+	case "ics":
+		return exportPlanICS(service, args[1:])
+	default:
+		return fmt.Errorf("알 수 없는 plan 명령어: %s", args[0])
+	}
+}
+
+func newPlan(service *restaurant.Service, args []string) error {
+	fs := flag.NewFlagSet("plan new", flag.ContinueOnError)
+	week := fs.String("week", "", "계획할 주의 아무 날짜 (YYYY-MM-DD, 기본값: 이번 주)")
+	mode := fs.String("mode", "", "추천 모드")
+	budget := fs.Int("budget", 0, "주간 예산 (원, 0이면 제한 없음)")
+	team := fs.String("team", "", "팀 점심인 요일 (쉼표로 구분, 예: 수)")
+	skip := fs.String("skip", "", "점심을 거르는 요일 (쉼표로 구분, 예: 금)")
+	seed := seedFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	kinds := map[string]string{}
+	for _, day := range splitList(*team) {
+		kinds[day] = restaurant.PlanTeam
+	}
+	for _, day := range splitList(*skip) {
+		kinds[day] = restaurant.PlanSkip
+	}
+
+	plan, err := service.NewPlan(restaurant.PlanOptions{
+		Week:   *week,
+		Mode:   *mode,
+		Budget: *budget,
+		Kinds:  kinds,
+		Seed:   seed.value(),
+	})
+	if err != nil {
+		return fmt.Errorf("계획 생성 실패: %w", err)
+	}
+	printPlan(plan)
+	return nil
+}
+
+func showPlan() error {
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	plan, err := service.GetPlan()
+	if err != nil {
+		return fmt.Errorf("계획 읽기 실패: %w", err)
+	}
+	if plan == nil {
+		fmt.Println("점심 계획이 없습니다. jmc plan new 로 만들어주세요.")
+		return nil
+	}
+	printPlan(plan)
+	return nil
+}
+
+func printPlan(plan *restaurant.Plan) {
+	fmt.Printf("%s 주 점심 계획 (모드 %s)\n", plan.Week, plan.Mode)
+	total := 0
+	for _, day := range plan.Days {
+		label := ""
+		switch day.Kind {
+		case restaurant.PlanTeam:
+			label = " [팀 점심]"
+		case restaurant.PlanSkip:
+			if day.Note != "" {
+				fmt.Printf("  %s %s  (건너뜀: %s)\n", day.Weekday, day.Date, day.Note)
+			} else {
+				fmt.Printf("  %s %s  (건너뜀)\n", day.Weekday, day.Date)
+			}
+			continue
+		default:
+			total += day.Price
+		}
+		if day.Restaurant == "" {
+			fmt.Printf("  %s %s  - %s\n", day.Weekday, day.Date, day.Note)
+			continue
+		}
+		fmt.Printf("  %s %s  %s%s %s\n", day.Weekday, day.Date, day.Restaurant, label, formatWon(day.Price))
+	}
+	if plan.Budget > 0 {
+		fmt.Printf("예상 지출 %s / 예산 %s\n", formatWon(total), formatWon(plan.Budget))
+	}
+}
+
+func exportPlanICS(service *restaurant.Service, args []string) error {
+	plan, err := service.GetPlan()
+	if err != nil {
+		return fmt.Errorf("계획 읽기 실패: %w", err)
+	}
+	if plan == nil {
+		return fmt.Errorf("점심 계획이 없습니다")
+	}
+	ics := plan.ICS(time.Now())
+	if len(args) == 0 {
+		fmt.Print(ics)
+		return nil
+	}
+	if err := os.WriteFile(args[0], []byte(ics), 0644); err != nil {
+		return fmt.Errorf("ICS 저장 실패: %w", err)
+	}
+	fmt.Printf("%s 에 저장했습니다.\n", args[0])
+	return nil
+}
+
+// 금액을 회계 단위로 표시함 (예: 12,000원). 0이면 빈 문자열
+func formatWon(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return restaurant.FormatPrice(n) + "원"
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 팀원의 식단 프로필 관리. 추천은 프로필의 조건을 반드시 지킨다.
+//
+//	jmc profile                                 프로필 목록
+//	jmc profile set <이름> [--vegetarian] [--no-pork] [--no-seafood] [--max-spicy 0~3] [--allergens 게,새우]
+//	jmc profile rm <이름>
+//	jmc profile default [이름,...]              --for 없이 추천할 때 적용할 프로필 (비우면 적용하지 않음)
+func Profile(args []string) error {
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	if len(args) == 0 {
+		return listProfiles(service)
+	}
+	switch args[0] {
+	case "set":
+		return setProfile(service, args[1:])
+	case "rm":
+		if len(args) != 2 {
+			return fmt.Errorf("사용법: jmc profile rm <이름>")
+		}
+		if err := service.DeleteProfile(args[1]); err != nil {
+			return fmt.Errorf("프로필 삭제 실패: %w", err)
+		}
+		fmt.Printf("%s 프로필을 삭제했습니다.\n", args[1])
+		return nil
+	case "default":
+		if len(args) > 2 {
+			return fmt.Errorf("사용법: jmc profile default [이름,...]")
+		}
+		names := []string{}
+		if len(args) == 2 {
+			names = splitList(args[1])
+		}
+		if err := service.SetDefaultProfiles(names); err != nil {
+			return fmt.Errorf("기본 프로필 저장 실패: %w", err)
+		}
+		if len(names) == 0 {
+			fmt.Println("기본 프로필을 비웠습니다.")
+		} else {
+			fmt.Printf("기본 프로필을 %s(으)로 정했습니다.\n", strings.Join(names, ", "))
+		}
+		return nil
+	default:
+		return fmt.Errorf("알 수 없는 profile 명령어: %s", args[0])
+	}
+}
+
+func listProfiles(service *restaurant.Service) error {
+	profiles, err := service.Profiles()
+	if err != nil {
+		return fmt.Errorf("프로필 조회 실패: %w", err)
+	}
+	if len(profiles) == 0 {
+		fmt.Println("등록된 프로필이 없습니다. jmc profile set <이름>으로 추가할 수 있습니다.")
+		return nil
+	}
+	for _, p := range profiles {
+		fmt.Printf("%s: %s\n", p.Name, p.Summary())
+	}
+	return nil
+}
+
+func setProfile(service *restaurant.Service, args []string) error {
+	fs := flag.NewFlagSet("profile set", flag.ContinueOnError)
+	vegetarian := fs.Bool("vegetarian", false, "채식 (고기와 해산물을 먹지 않음)")
+	noPork := fs.Bool("no-pork", false, "돼지고기를 먹지 않음")
+	noSeafood := fs.Bool("no-seafood", false, "해산물을 먹지 않음")
+	maxSpicy := fs.Int("max-spicy", -1, "먹을 수 있는 가장 매운 단계 (0~3)")
+	allergens := fs.String("allergens", "", "알레르기 성분 (쉼표로 구분, 예: 게,새우,조개류)")
+	rest, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 1 {
+		return fmt.Errorf("사용법: jmc profile set <이름> [--vegetarian] [--no-pork] [--no-seafood] [--max-spicy 0~3] [--allergens 게,새우]")
+	}
+
+	profile := restaurant.DietProfile{
+		Name:       rest[0],
+		Vegetarian: *vegetarian,
+		NoPork:     *noPork,
+		NoSeafood:  *noSeafood,
+		Allergens:  splitList(*allergens),
+	}
+	if *maxSpicy >= 0 {
+		profile.MaxSpicy = maxSpicy
+	}
+	saved, err := service.SetProfile(profile)
+	if err != nil {
+		return fmt.Errorf("프로필 저장 실패: %w", err)
+	}
+	fmt.Printf("%s 프로필을 저장했습니다: %s\n", saved.Name, saved.Summary())
+	return nil
+}
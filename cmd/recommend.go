@@ -1,28 +1,176 @@
 package cmd
 
 import (
+	"errors"
+	"flag"
 	"fmt"
-	"math/rand"
-	"os"
+	"strconv"
 	"time"
 
 	"github.com/arch-spatula/jmc/internal/restaurant"
 )
 
+const dataFile = "data.json"
+
 // 식당 목록을 추천함
-func Recommend() {
-	// TODO: 식당 목록은 파일에서 읽어옴
-	repo := restaurant.NewRepository("data.json")
-	data, err := repo.FindAll()
+// -n 으로 중복 없이 여러 식당을 추천받을 수 있다.
+func Recommend(args []string) error {
+	fs := flag.NewFlagSet("jmc", flag.ContinueOnError)
+	count := fs.Int("n", 1, "추천받을 식당 수")
+	mode := fs.String("mode", "", "추천 모드 (예: 일상, 탐방)")
+	explain := fs.Bool("explain", false, "추천 이유를 점수별로 출력")
+	seed := seedFlag(fs)
+	at := fs.String("at", "", "도착 시각 (HH:MM). 이 시각에 영업 중인 식당만 추천")
+	from := fs.String("from", "", "출발 위치 (cli_config.origins의 이름, 기본값은 첫 번째)")
+	maxWalk := walkFlag(fs)
+	region := fs.String("region", "", "지역 (하위 지역 포함, 예: 강남구)")
+	query := queryFlag(fs)
+	budget := fs.Int("budget", 0, "예산 (원). 가장 싼 메뉴가 이보다 비싼 식당은 빼고 추천")
+	profiles := profilesFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	arrival, err := parseAt(*at)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "식당 목록 읽기 실패: %v\n", err)
-		os.Exit(1)
+		return err
+	}
+	return recommend(restaurant.RecommendOptions{Count: *count, Mode: *mode, Seed: seed.value(), At: arrival, From: *from, MaxWalk: *maxWalk, Region: *region, Query: *query, Budget: *budget, Profiles: splitList(*profiles)}, *explain)
+}
+
+// --max-walk 플래그. 예: 10m, 15
+func walkFlag(fs *flag.FlagSet) *time.Duration {
+	d := new(time.Duration)
+	fs.Func("max-walk", "최대 도보 시간 (예: 10m). 출발 위치에서 이 시간 안에 걸어갈 수 있는 식당만", func(v string) error {
+		parsed, err := restaurant.ParseMaxWalk(v)
+		if err != nil {
+			return err
+		}
+		*d = parsed
+		return nil
+	})
+	return d
+}
+
+// -q 플래그. 예: -q "rating>=4 category:한식 -visited"
+func queryFlag(fs *flag.FlagSet) *string {
+	return fs.String("q", "", "검색식 (예: \"rating>=4 category:한식 -visited\")")
+}
+
+// --for 플래그. 예: --for 영희,민수
+func profilesFlag(fs *flag.FlagSet) *string {
+	return fs.String("for", "", "같이 먹는 사람의 식단 프로필 (쉼표로 구분, 기본값은 cli_config.default_profiles)")
+}
+
+// 검색식 오류면 틀린 위치를 가리키는 줄을 덧붙임
+func queryCaret(err error) string {
+	var qerr *restaurant.QueryError
+	if !errors.As(err, &qerr) {
+		return ""
 	}
+	return "\n" + qerr.Caret()
+}
+
+// --at 플래그. 비어 있으면 지금 도착한다고 본다.
+func parseAt(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := restaurant.ParseArrival(s, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// --seed 플래그. 주어졌을 때만 시드를 고정한다.
+type optionalSeed struct {
+	set bool
+	n   int64
+}
+
+func seedFlag(fs *flag.FlagSet) *optionalSeed {
+	s := &optionalSeed{}
+	fs.Func("seed", "난수 시드 (같은 시드면 같은 추천)", func(v string) error {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("seed는 정수여야 합니다: %s", v)
+		}
+		s.set, s.n = true, n
+		return nil
+	})
+	return s
+}
 
-	// 식당 목록은 랜덤으로 추천함
-	source := rand.NewSource(time.Now().UnixNano())
-	r := rand.New(source)
-	randomIndex := r.Intn(len(data.Restaurants))
+func (s *optionalSeed) value() *int64 {
+	if !s.set {
+		return nil
+	}
+	return &s.n
+}
+
+func recommend(opts restaurant.RecommendOptions, explain bool) error {
+	if opts.Count <= 0 {
+		return fmt.Errorf("추천 개수는 1 이상이어야 합니다: %d", opts.Count)
+	}
+
+	printTodayHoliday()
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	var picks, excluded []restaurant.Candidate
+	var err error
+	if explain {
+		picks, excluded, err = service.RecommendExplained(opts)
+	} else {
+		picks, err = service.Recommend(opts)
+	}
+	if err != nil {
+		return fmt.Errorf("식당 추천 실패: %w%s", err, queryCaret(err))
+	}
+	if len(picks) == 0 {
+		fmt.Println("추천할 식당이 없습니다.")
+	}
+
+	for _, p := range picks {
+		printRestaurant(p.Restaurant)
+		if p.Walk != nil {
+			fmt.Printf("  %s에서 %s\n", p.Walk.From, p.Walk)
+		}
+		if p.Prices != nil {
+			fmt.Printf("  가격 %s\n", p.Prices)
+		}
+		if p.Order != nil {
+			fmt.Printf("  추천 메뉴: %s\n", formatPick(*p.Order))
+		}
+		if explain {
+			printExplanation(p.Explain)
+		}
+	}
+	printExcluded(excluded)
+	return nil
+}
+
+// 추천 이유를 요인별로 출력함
+func printExplanation(e restaurant.Explanation) {
+	if e.Probability > 0 {
+		fmt.Printf("  모드 %s, 점수 %.2f, 선택 확률 %.1f%%\n", e.Mode, e.Score, e.Probability*100)
+	} else {
+		fmt.Printf("  모드 %s, 점수 %.2f\n", e.Mode, e.Score)
+	}
+	for _, f := range e.Factors {
+		fmt.Printf("    %+6.2f %-9s %s\n", f.Value, f.Name, f.Detail)
+	}
+}
+
+// 규칙에 걸려 추천에서 뺀 식당과 이유를 출력함
+func printExcluded(excluded []restaurant.Candidate) {
+	if len(excluded) == 0 {
+		return
+	}
+	fmt.Println("규칙으로 뺀 식당:")
+	for _, c := range excluded {
+		fmt.Printf("  %s: %s\n", c.Name, c.Explain.Excluded)
+	}
+}
 
-	fmt.Printf("%s %.1f %s %s\n", data.Restaurants[randomIndex].Name, data.Restaurants[randomIndex].Rating, data.Restaurants[randomIndex].Categories, data.Restaurants[randomIndex].KakaoURL)
+func printRestaurant(r restaurant.Restaurant) {
+	fmt.Printf("%s %.1f %s %s\n", r.Name, r.Rating, r.Categories, r.KakaoURL)
 }
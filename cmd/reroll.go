@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"flag"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 직전 추천을 거절하고 다시 추천함
+// 거절한 식당은 오늘 하루 동안 다시 추천되지 않는다.
+func Reroll(args []string) error {
+	fs := flag.NewFlagSet("reroll", flag.ContinueOnError)
+	count := fs.Int("n", 1, "추천받을 식당 수")
+	mode := fs.String("mode", "", "추천 모드 (예: 일상, 탐방)")
+	explain := fs.Bool("explain", false, "추천 이유를 점수별로 출력")
+	seed := seedFlag(fs)
+	at := fs.String("at", "", "도착 시각 (HH:MM). 이 시각에 영업 중인 식당만 추천")
+	from := fs.String("from", "", "출발 위치 (cli_config.origins의 이름, 기본값은 첫 번째)")
+	maxWalk := walkFlag(fs)
+	region := fs.String("region", "", "지역 (하위 지역 포함, 예: 강남구)")
+	query := queryFlag(fs)
+	budget := fs.Int("budget", 0, "예산 (원). 가장 싼 메뉴가 이보다 비싼 식당은 빼고 추천")
+	profiles := profilesFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	arrival, err := parseAt(*at)
+	if err != nil {
+		return err
+	}
+	return recommend(restaurant.RecommendOptions{Count: *count, Reroll: true, Mode: *mode, Seed: seed.value(), At: arrival, From: *from, MaxWalk: *maxWalk, Region: *region, Query: *query, Budget: *budget, Profiles: splitList(*profiles)}, *explain)
+}
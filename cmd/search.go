@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 식당을 검색함. 검색어는 여러 낱말이어도 된다.
+func Search(args []string) error {
+	fs := flag.NewFlagSet("search", flag.ContinueOnError)
+	limit := fs.Int("n", 10, "최대 결과 수 (0이면 모두)")
+	rest, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	query := strings.Join(rest, " ")
+	if strings.TrimSpace(query) == "" {
+		return fmt.Errorf("사용법: jmc search [-n 개수] <검색어>")
+	}
+
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	results, err := service.Search(query, *limit)
+	if err != nil {
+		return fmt.Errorf("검색 실패: %w", err)
+	}
+	if len(results) == 0 {
+		fmt.Println("검색 결과가 없습니다.")
+		return nil
+	}
+	for _, r := range results {
+		fmt.Printf("%5.2f %s\n", r.Score, formatListing(r.Restaurant))
+		for _, m := range r.Matches {
+			fmt.Printf("      %s %s: %s\n", m.Kind, m.Field, m.Text)
+		}
+	}
+	return nil
+}
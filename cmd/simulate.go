@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 방문 기록을 재생해 추천 전략을 비교함
+func Simulate(args []string) error {
+	fs := flag.NewFlagSet("simulate", flag.ContinueOnError)
+	mode := fs.String("mode", "", "기준 모드 (전략만 바꿔가며 비교)")
+	steps := fs.Int("days", 0, "시뮬레이션할 날 수 (기본값: 방문 기록 수)")
+	seed := fs.Int64("seed", 1, "난수 시드")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	repo := restaurant.NewRepository(dataFile)
+	data, err := repo.FindAll()
+	if err != nil {
+		return fmt.Errorf("식당 목록 읽기 실패: %w", err)
+	}
+	results, err := restaurant.Simulate(data, restaurant.SimulateOptions{Mode: *mode, Steps: *steps, Seed: *seed})
+	if err != nil {
+		return fmt.Errorf("시뮬레이션 실패: %w", err)
+	}
+
+	fmt.Printf("%-10s %6s %8s %8s %10s\n", "전략", "일수", "평균평점", "누적후회", "다양성")
+	for _, r := range results {
+		fmt.Printf("%-10s %6d %8.2f %8.2f %4d (%3.0f%%)\n", r.Strategy, r.Steps, r.AvgRating, r.Regret, r.Distinct, r.Diversity*100)
+	}
+	return nil
+}
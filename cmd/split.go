@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 같이 먹은 점심의 더치페이
+//
+//	jmc split                                  사람별 잔액과 송금 횟수가 가장 적은 정산 방법
+//	jmc split add <식당> --paid 철수 --order "철수:순대국, 영희:수육=12000" --shared "모둠전"
+//	                                           계산서 기록. 가격을 생략하면 메뉴 가격을 쓴다.
+//	jmc split pay <보낸 사람> <받은 사람> <금액>   정산으로 보낸 돈 기록
+func Split(args []string) error {
+	if len(args) == 0 {
+		service := restaurant.NewService(restaurant.NewRepository(dataFile))
+		settlement, err := service.Settlement()
+		if err != nil {
+			return fmt.Errorf("정산 조회 실패: %w", err)
+		}
+		printSettlement(settlement)
+		return nil
+	}
+	switch args[0] {
+	case "add":
+		return splitAdd(args[1:])
+	case "pay":
+		return splitPay(args[1:])
+	default:
+		return fmt.Errorf("알 수 없는 split 명령입니다: %s (add, pay)", args[0])
+	}
+}
+
+func splitAdd(args []string) error {
+	fs := flag.NewFlagSet("split add", flag.ContinueOnError)
+	paid := fs.String("paid", "", "계산한 사람")
+	orders := fs.String("order", "", "각자 주문한 메뉴 (사람:메뉴[=가격], 쉼표로 구분)")
+	shared := fs.String("shared", "", "모두 나눠 먹은 메뉴 (메뉴[=가격], 쉼표로 구분)")
+	date := fs.String("date", "", "날짜 (YYYY-MM-DD, 기본값 오늘)")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("사용법: jmc split add <식당> --paid <사람> --order \"사람:메뉴, ...\" [--shared \"메뉴, ...\"]")
+	}
+
+	bill := restaurant.Bill{Restaurant: positional[0], Date: *date, Payer: *paid}
+	for _, entry := range splitList(*orders) {
+		person, menu, ok := strings.Cut(entry, ":")
+		if !ok {
+			return fmt.Errorf("주문은 사람:메뉴 형식이어야 합니다: %s", entry)
+		}
+		item, err := parseBillItem(menu)
+		if err != nil {
+			return err
+		}
+		item.People = []string{strings.TrimSpace(person)}
+		bill.Items = append(bill.Items, item)
+	}
+	for _, entry := range splitList(*shared) {
+		item, err := parseBillItem(entry)
+		if err != nil {
+			return err
+		}
+		bill.Items = append(bill.Items, item)
+	}
+
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	saved, err := service.AddBill(bill)
+	if err != nil {
+		return fmt.Errorf("계산서 기록 실패: %w", err)
+	}
+	fmt.Printf("%s %s 계산서를 기록했습니다. 합계 %s원, %s 계산\n", saved.Date, saved.Restaurant, restaurant.FormatPrice(saved.Total()), saved.Payer)
+	for _, share := range saved.Shares() {
+		fmt.Printf("  %s %s원\n", share.Person, restaurant.FormatPrice(share.Amount))
+	}
+	return nil
+}
+
+// 메뉴[=가격]
+func parseBillItem(s string) (restaurant.BillItem, error) {
+	menu, price, ok := strings.Cut(s, "=")
+	item := restaurant.BillItem{Menu: strings.TrimSpace(menu)}
+	if ok {
+		n, err := strconv.Atoi(strings.TrimSpace(price))
+		if err != nil {
+			return item, fmt.Errorf("가격은 숫자여야 합니다: %s", s)
+		}
+		item.Price = n
+	}
+	return item, nil
+}
+
+func splitPay(args []string) error {
+	fs := flag.NewFlagSet("split pay", flag.ContinueOnError)
+	date := fs.String("date", "", "날짜 (YYYY-MM-DD, 기본값 오늘)")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 3 {
+		return fmt.Errorf("사용법: jmc split pay <보낸 사람> <받은 사람> <금액>")
+	}
+	amount, err := strconv.Atoi(positional[2])
+	if err != nil {
+		return fmt.Errorf("금액은 숫자여야 합니다: %s", positional[2])
+	}
+
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	saved, err := service.AddPayment(restaurant.Transfer{From: positional[0], To: positional[1], Amount: amount, Date: *date})
+	if err != nil {
+		return fmt.Errorf("송금 기록 실패: %w", err)
+	}
+	fmt.Printf("%s → %s %s원 송금을 기록했습니다. (%s)\n", saved.From, saved.To, restaurant.FormatPrice(saved.Amount), saved.Date)
+	return nil
+}
+
+func printSettlement(s *restaurant.Settlement) {
+	if len(s.Balances) == 0 {
+		fmt.Println("정산할 금액이 없습니다.")
+		return
+	}
+	fmt.Println("잔액")
+	for _, b := range s.Balances {
+		if b.Amount > 0 {
+			fmt.Printf("  %s %s원 받을 돈\n", b.Person, restaurant.FormatPrice(b.Amount))
+		} else {
+			fmt.Printf("  %s %s원 보낼 돈\n", b.Person, restaurant.FormatPrice(-b.Amount))
+		}
+	}
+	fmt.Printf("정산 방법 (%d번)\n", len(s.Transfers))
+	for _, t := range s.Transfers {
+		fmt.Printf("  %s → %s %s원\n", t.From, t.To, restaurant.FormatPrice(t.Amount))
+	}
+}
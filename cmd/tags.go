@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 카테고리 태그 관리
+//
+//	jmc tags                         카테고리 분류와 태그별 식당 수
+//	jmc tags rename <태그> <새 이름>    모든 식당의 태그 이름 바꾸기
+//	jmc tags merge <대상> <태그>...     여러 태그를 대상 태그로 합치기
+func Tags(args []string) error {
+	service := restaurant.NewService(restaurant.NewRepository(dataFile))
+	if len(args) == 0 || args[0] == "list" {
+		return showTags(service)
+	}
+
+	switch args[0] {
+	case "rename":
+		if len(args) != 3 {
+			return fmt.Errorf("사용법: jmc tags rename <태그> <새 이름>")
+		}
+		return renameTags(service, args[1:2], args[2])
+	case "merge":
+		if len(args) < 3 {
+			return fmt.Errorf("사용법: jmc tags merge <대상> <태그>...")
+		}
+		return renameTags(service, args[2:], args[1])
+	default:
+		return fmt.Errorf("알 수 없는 tags 명령어: %s", args[0])
+	}
+}
+
+func showTags(service *restaurant.Service) error {
+	tree, err := service.Categories()
+	if err != nil {
+		return fmt.Errorf("카테고리 조회 실패: %w", err)
+	}
+	printTerms(tree.Categories, 0)
+	if len(tree.Others) > 0 {
+		fmt.Println("분류에 없는 태그")
+		printTerms(tree.Others, 1)
+	}
+	return nil
+}
+
+func printTerms(nodes []restaurant.TermNode, depth int) {
+	for _, node := range nodes {
+		line := fmt.Sprintf("%s%s (%d)", strings.Repeat("  ", depth), node.Name, node.Count)
+		if len(node.Aliases) > 0 {
+			line += " 별칭: " + strings.Join(node.Aliases, ", ")
+		}
+		fmt.Println(line)
+		printTerms(node.Children, depth+1)
+	}
+}
+
+func renameTags(service *restaurant.Service, from []string, to string) error {
+	changed, err := service.RenameTags(from, to)
+	if err != nil {
+		return fmt.Errorf("태그 변경 실패: %w", err)
+	}
+	fmt.Printf("%s → %s: 식당 %d곳의 태그를 바꿨습니다.\n", strings.Join(from, ", "), to, changed)
+	return nil
+}
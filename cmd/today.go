@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/arch-spatula/jmc/internal/restaurant"
+)
+
+// 오늘의 추천을 출력함
+// 날짜, 팀 비밀값(cli_config.team_secret), 식당 목록이 같으면 팀원 모두 같은 식당을 받는다.
+func Today(args []string) error {
+	fs := flag.NewFlagSet("today", flag.ContinueOnError)
+	explain := fs.Bool("explain", false, "추천 이유를 점수별로 출력")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	repo := restaurant.NewRepository(dataFile)
+	data, err := repo.FindAll()
+	if err != nil {
+		return fmt.Errorf("식당 목록 읽기 실패: %w", err)
+	}
+	fmt.Printf("오늘의 추천 (%s, rev %s)\n", time.Now().Format("2006-01-02"), data.Revision())
+	return recommend(restaurant.RecommendOptions{Count: 1, Daily: true}, *explain)
+}
@@ -55,11 +55,37 @@ func Wiki() {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", controller.HandlePage)
 	mux.Handle("/static/", controller.StaticFiles(wikiFiles))
-	mux.HandleFunc("GET /api/restaurants", controller.HandleGetAll)
+	mux.HandleFunc("GET /api/restaurants", controller.HandleList)
+	mux.HandleFunc("GET /api/restaurants/{name}", controller.HandleGet)
 	mux.HandleFunc("GET /api/restaurants/recommend", controller.HandleRecommend)
+	mux.HandleFunc("POST /api/restaurants/reroll", controller.HandleReroll)
+	mux.HandleFunc("GET /api/today", controller.HandleToday)
+	mux.HandleFunc("GET /api/plan", controller.HandleGetPlan)
+	mux.HandleFunc("POST /api/plan", controller.HandleCreatePlan)
+	mux.HandleFunc("POST /api/plan/days/{day}/reroll", controller.HandleRerollPlanDay)
+	mux.HandleFunc("GET /api/plan/ics", controller.HandlePlanICS)
+	mux.HandleFunc("GET /api/locations", controller.HandleLocations)
+	mux.HandleFunc("GET /api/categories", controller.HandleCategories)
+	mux.HandleFunc("GET /api/search", controller.HandleSearch)
 	mux.HandleFunc("POST /api/restaurants", controller.HandleCreate)
 	mux.HandleFunc("PUT /api/restaurants/{name}", controller.HandleUpdate)
 	mux.HandleFunc("DELETE /api/restaurants/{name}", controller.HandleDelete)
+	mux.HandleFunc("POST /api/restaurants/{name}/accept", controller.HandleAccept)
+	mux.HandleFunc("GET /api/restaurants/{name}/menus", controller.HandleMenus)
+	mux.HandleFunc("POST /api/restaurants/{name}/menus", controller.HandleCreateMenu)
+	mux.HandleFunc("GET /api/restaurants/{name}/menus/recommend", controller.HandleRecommendMenu)
+	mux.HandleFunc("GET /api/restaurants/{name}/menus/{menu}/prices", controller.HandleMenuPrices)
+	mux.HandleFunc("GET /api/prices/increases", controller.HandlePriceIncreases)
+	mux.HandleFunc("GET /api/spending", controller.HandleSpending)
+	mux.HandleFunc("GET /api/settlements", controller.HandleSettlement)
+	mux.HandleFunc("POST /api/settlements/bills", controller.HandleCreateBill)
+	mux.HandleFunc("POST /api/settlements/payments", controller.HandleCreatePayment)
+	mux.HandleFunc("GET /api/profiles", controller.HandleProfiles)
+	mux.HandleFunc("PUT /api/profiles/{name}", controller.HandleSetProfile)
+	mux.HandleFunc("DELETE /api/profiles/{name}", controller.HandleDeleteProfile)
+	mux.HandleFunc("PUT /api/restaurants/{name}/menus/order", controller.HandleReorderMenus)
+	mux.HandleFunc("PATCH /api/restaurants/{name}/menus/{menu}", controller.HandleUpdateMenu)
+	mux.HandleFunc("DELETE /api/restaurants/{name}/menus/{menu}", controller.HandleDeleteMenu)
 	mux.HandleFunc("POST /api/restaurants/save", controller.HandleSave)
 
 	http.ListenAndServe(addr, loggingMiddleware(mux))
@@ -0,0 +1,53 @@
+// geo 패키지는 좌표 사이의 거리와 도보 시간을 계산한다.
+package geo
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const earthRadius = 6371000.0 // 미터
+
+// 걷는 속도 기본값 (분당 미터, 약 4km/h)
+const DefaultWalkSpeed = 67.0
+
+// 실제 길은 직선보다 돌아가므로 직선거리에 곱하는 값
+const detour = 1.3
+
+type Point struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+func (p Point) Validate() error {
+	if p.Lat < -90 || p.Lat > 90 {
+		return fmt.Errorf("위도는 -90~90 사이여야 합니다: %v", p.Lat)
+	}
+	if p.Lng < -180 || p.Lng > 180 {
+		return fmt.Errorf("경도는 -180~180 사이여야 합니다: %v", p.Lng)
+	}
+	return nil
+}
+
+// 하버사인 공식으로 구한 두 좌표 사이의 직선거리 (미터)
+func Distance(a, b Point) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := (b.Lat - a.Lat) * math.Pi / 180
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+// 직선거리로 어림한 도보 시간
+// speed는 분당 미터이고 0 이하면 DefaultWalkSpeed를 쓴다.
+func WalkTime(meters, speed float64) time.Duration {
+	if speed <= 0 {
+		speed = DefaultWalkSpeed
+	}
+	minutes := meters * detour / speed
+	return time.Duration(math.Ceil(minutes)) * time.Minute
+}
@@ -0,0 +1,38 @@
+package geo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDistance(t *testing.T) {
+	// 강남역 → 역삼역 약 800m
+	gangnam := Point{Lat: 37.49794, Lng: 127.02762}
+	yeoksam := Point{Lat: 37.50064, Lng: 127.03637}
+	d := Distance(gangnam, yeoksam)
+	if d < 750 || d > 850 {
+		t.Fatalf("약 800m를 기대했지만 %.0fm", d)
+	}
+	if Distance(gangnam, gangnam) != 0 {
+		t.Fatal("같은 좌표의 거리가 0이 아님")
+	}
+}
+
+func TestWalkTime(t *testing.T) {
+	// 670m * 1.3 / 67 = 13분
+	if got := WalkTime(670, 0); got != 13*time.Minute {
+		t.Fatalf("13분을 기대했지만 %v", got)
+	}
+	if got := WalkTime(100, 130); got != time.Minute {
+		t.Fatalf("1분을 기대했지만 %v", got)
+	}
+}
+
+func TestPointValidate(t *testing.T) {
+	if err := (Point{Lat: 91, Lng: 0}).Validate(); err == nil {
+		t.Fatal("위도가 범위 밖인데 에러가 발생하지 않음")
+	}
+	if err := (Point{Lat: 37.5, Lng: 127}).Validate(); err != nil {
+		t.Fatalf("유효한 좌표인데 에러 발생: %v", err)
+	}
+}
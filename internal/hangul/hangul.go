@@ -0,0 +1,178 @@
+// hangul 패키지는 외부 패키지 없이 한글 문자열을 다룬다.
+package hangul
+
+import "strings"
+
+// 한글 음절과 조합형 자모의 유니코드 범위
+const (
+	syllableBase  = 0xAC00
+	choseongBase  = 0x1100
+	jungseongBase = 0x1161
+	jongseongBase = 0x11A7
+
+	choseongCount  = 19
+	jungseongCount = 21
+	jongseongCount = 28
+	syllableCount  = choseongCount * jungseongCount * jongseongCount
+)
+
+func isSyllable(r rune) bool {
+	return r >= syllableBase && r < syllableBase+syllableCount
+}
+
+// 풀어쓴 한글 자모(NFD)를 음절로 합쳐 NFC로 만듦
+// macOS 파일 이름이나 일부 입력기에서 온 "ㅎ+ㅏ+ㄴ" 형태의 글자를 "한"으로 바꾼다.
+// 한글 이외 문자의 정규화는 하지 않는다.
+func NFC(s string) string {
+	if !strings.ContainsFunc(s, func(r rune) bool { return r >= choseongBase && r < jongseongBase+jongseongCount }) {
+		return s
+	}
+	out := make([]rune, 0, len(s))
+	for _, r := range s {
+		if n := len(out); n > 0 {
+			last := out[n-1]
+			// 초성 + 중성
+			if last >= choseongBase && last < choseongBase+choseongCount && r >= jungseongBase && r < jungseongBase+jungseongCount {
+				out[n-1] = syllableBase + ((last-choseongBase)*jungseongCount+(r-jungseongBase))*jongseongCount
+				continue
+			}
+			// 받침 없는 음절 + 종성
+			if isSyllable(last) && (last-syllableBase)%jongseongCount == 0 && r > jongseongBase && r < jongseongBase+jongseongCount {
+				out[n-1] = last + (r - jongseongBase)
+				continue
+			}
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+// 호환용 자모 (ㄱ, ㅏ 등 키보드로 입력하는 낱자)
+var (
+	choseongs  = []rune("ㄱㄲㄴㄷㄸㄹㅁㅂㅃㅅㅆㅇㅈㅉㅊㅋㅌㅍㅎ")
+	jungseongs = []rune("ㅏㅐㅑㅒㅓㅔㅕㅖㅗㅘㅙㅚㅛㅜㅝㅞㅟㅠㅡㅢㅣ")
+	// 0번은 받침 없음
+	jongseongs = []rune(" ㄱㄲㄳㄴㄵㄶㄷㄹㄺㄻㄼㄽㄾㄿㅀㅁㅂㅄㅅㅆㅇㅈㅊㅋㅌㅍㅎ")
+)
+
+// 겹모음과 겹받침을 낱자로 나눔. 오타를 자모 하나 차이로 보기 위해 쓴다.
+var compound = map[rune]string{
+	'ㅘ': "ㅗㅏ", 'ㅙ': "ㅗㅐ", 'ㅚ': "ㅗㅣ", 'ㅝ': "ㅜㅓ", 'ㅞ': "ㅜㅔ", 'ㅟ': "ㅜㅣ", 'ㅢ': "ㅡㅣ",
+	'ㄳ': "ㄱㅅ", 'ㄵ': "ㄴㅈ", 'ㄶ': "ㄴㅎ", 'ㄺ': "ㄹㄱ", 'ㄻ': "ㄹㅁ", 'ㄼ': "ㄹㅂ",
+	'ㄽ': "ㄹㅅ", 'ㄾ': "ㄹㅌ", 'ㄿ': "ㄹㅍ", 'ㅀ': "ㄹㅎ", 'ㅄ': "ㅂㅅ",
+}
+
+// 음절을 초성, 중성, 종성 번호로 나눔
+func split(r rune) (cho, jung, jong int) {
+	n := int(r - syllableBase)
+	return n / (jungseongCount * jongseongCount), n / jongseongCount % jungseongCount, n % jongseongCount
+}
+
+// 한글 음절을 호환용 자모로 풀어씀. 겹모음과 겹받침도 낱자로 나눈다.
+// 예: "김밥" → "ㄱㅣㅁㅂㅏㅂ". 한글이 아닌 문자는 그대로 둔다.
+func Decompose(s string) string {
+	var b strings.Builder
+	write := func(r rune) {
+		if parts, ok := compound[r]; ok {
+			b.WriteString(parts)
+			return
+		}
+		b.WriteRune(r)
+	}
+	for _, r := range NFC(s) {
+		if !isSyllable(r) {
+			write(r)
+			continue
+		}
+		cho, jung, jong := split(r)
+		write(choseongs[cho])
+		write(jungseongs[jung])
+		if jong > 0 {
+			write(jongseongs[jong])
+		}
+	}
+	return b.String()
+}
+
+// 한글 음절을 초성으로 바꿈. 예: "김밥천국" → "ㄱㅂㅊㄱ". 한글이 아닌 문자는 그대로 둔다.
+func Choseong(s string) string {
+	var b strings.Builder
+	for _, r := range NFC(s) {
+		if isSyllable(r) {
+			cho, _, _ := split(r)
+			r = choseongs[cho]
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// 자음 낱자인지 확인함 (ㄱ~ㅎ)
+func IsConsonant(r rune) bool {
+	return r >= 'ㄱ' && r <= 'ㅎ'
+}
+
+// 글자가 있고 모두 자음 낱자거나 공백이면 초성 검색어로 봄
+func IsChoseongQuery(s string) bool {
+	found := false
+	for _, r := range s {
+		switch {
+		case IsConsonant(r):
+			found = true
+		case r == ' ':
+		default:
+			return false
+		}
+	}
+	return found
+}
+
+// 국어의 로마자 표기법(문화체육관광부)에 따른 표기
+var (
+	romanInitials = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	romanVowels   = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+	// 받침 뒤에 자음이 오거나 끝날 때의 대표음
+	romanFinals = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+	// 받침 뒤에 모음(초성 ㅇ)이 와서 받침이 다음 음절로 넘어갈 때
+	romanLinked = []string{"", "g", "kk", "gs", "n", "nj", "n", "d", "r", "lg", "lm", "lb", "ls", "lt", "lp", "r", "m", "b", "bs", "s", "ss", "ng", "j", "ch", "k", "t", "p", ""}
+)
+
+// 한글을 로마자로 바꿈. 예: "김밥" → "gimbap", "떡볶이" → "tteokbokki"
+// 받침이 모음 앞에서 넘어가는 연음과 ㄹㄹ → ll만 반영하고 나머지 음운 변화는 무시한다.
+// 한글이 아닌 문자는 소문자로 바꿔 그대로 둔다.
+func Romanize(s string) string {
+	runes := []rune(NFC(s))
+	var b strings.Builder
+	for i, r := range runes {
+		if !isSyllable(r) {
+			b.WriteString(strings.ToLower(string(r)))
+			continue
+		}
+		cho, jung, jong := split(r)
+		if i > 0 && isSyllable(runes[i-1]) {
+			_, _, prevJong := split(runes[i-1])
+			switch {
+			case cho == 11 && prevJong > 0 && prevJong != 21:
+				// 앞 받침이 이 음절의 초성으로 넘어옴 (ㅇ 받침은 넘어가지 않음)
+			case cho == 5 && prevJong == 8:
+				b.WriteString("l")
+			default:
+				b.WriteString(romanInitials[cho])
+			}
+		} else {
+			b.WriteString(romanInitials[cho])
+		}
+		b.WriteString(romanVowels[jung])
+		if jong == 0 {
+			continue
+		}
+		if i+1 < len(runes) && isSyllable(runes[i+1]) {
+			if nextCho, _, _ := split(runes[i+1]); nextCho == 11 && jong != 21 {
+				b.WriteString(romanLinked[jong])
+				continue
+			}
+		}
+		b.WriteString(romanFinals[jong])
+	}
+	return b.String()
+}
@@ -0,0 +1,54 @@
+package hangul
+
+import "testing"
+
+func TestNFC(t *testing.T) {
+	// "한식"을 조합형 자모로 풀어쓴 문자열
+	nfd := "한식"
+	if got := NFC(nfd); got != "한식" {
+		t.Fatalf("한식을 기대했지만 %q", got)
+	}
+	if got := NFC("국밥 Korean"); got != "국밥 Korean" {
+		t.Fatalf("이미 NFC인 문자열이 바뀜: %q", got)
+	}
+	// 초성만 있는 자모는 그대로 둠
+	if got := NFC("ᄀx"); got != "ᄀx" {
+		t.Fatalf("합칠 수 없는 자모가 바뀜: %q", got)
+	}
+}
+
+func TestDecompose(t *testing.T) {
+	if got := Decompose("김밥"); got != "ㄱㅣㅁㅂㅏㅂ" {
+		t.Fatalf("ㄱㅣㅁㅂㅏㅂ을 기대했지만 %q", got)
+	}
+	// 겹모음과 겹받침은 낱자로 나눔
+	if got := Decompose("닭과"); got != "ㄷㅏㄹㄱㄱㅗㅏ" {
+		t.Fatalf("ㄷㅏㄹㄱㄱㅗㅏ를 기대했지만 %q", got)
+	}
+}
+
+func TestChoseong(t *testing.T) {
+	if got := Choseong("김밥천국 2호점"); got != "ㄱㅂㅊㄱ 2ㅎㅈ" {
+		t.Fatalf("ㄱㅂㅊㄱ 2ㅎㅈ을 기대했지만 %q", got)
+	}
+	if !IsChoseongQuery("ㄱㅂ ㅊㄱ") || IsChoseongQuery("김ㅂ") || IsChoseongQuery(" ") {
+		t.Fatal("초성 검색어 판별이 잘못됨")
+	}
+}
+
+func TestRomanize(t *testing.T) {
+	cases := map[string]string{
+		"김밥":     "gimbap",
+		"국밥":     "gukbap",
+		"떡볶이":    "tteokbokki",
+		"불고기":    "bulgogi",
+		"설렁탕":    "seolleongtang",
+		"강남역":    "gangnamyeok",
+		"CU 편의점": "cu pyeonuijeom",
+	}
+	for input, want := range cases {
+		if got := Romanize(input); got != want {
+			t.Fatalf("%s: %s를 기대했지만 %s", input, want, got)
+		}
+	}
+}
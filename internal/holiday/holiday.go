@@ -0,0 +1,75 @@
+// holiday 패키지는 네트워크 없이 쓸 수 있는 한국 공휴일 표를 제공한다.
+package holiday
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+type Holiday struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+	// 대체공휴일 여부
+	Substitute bool `json:"substitute"`
+}
+
+var byDate = func() map[string]Holiday {
+	m := make(map[string]Holiday, len(table))
+	for _, h := range table {
+		m[h.Date] = h
+	}
+	return m
+}()
+
+// 표에 그 해의 공휴일이 모두 들어 있는지 확인함
+func Covered(year int) bool {
+	return year >= FirstYear && year <= LastYear
+}
+
+// t 날짜의 공휴일을 찾음
+// 표에 없는 연도는 양력 고정 공휴일만 알 수 있다.
+func Lookup(t time.Time) (Holiday, bool) {
+	date := t.Format(dateLayout)
+	if Covered(t.Year()) {
+		h, ok := byDate[date]
+		return h, ok
+	}
+	for _, f := range fixed {
+		if strings.HasSuffix(date, f.monthDay) {
+			return Holiday{Date: date, Name: f.name}, true
+		}
+	}
+	return Holiday{}, false
+}
+
+func IsHoliday(t time.Time) bool {
+	_, ok := Lookup(t)
+	return ok
+}
+
+// 설날, 추석 연휴(대체공휴일 포함)인지 확인함
+func IsMyeongjeol(t time.Time) bool {
+	h, ok := Lookup(t)
+	if !ok {
+		return false
+	}
+	return strings.HasPrefix(h.Name, "설날") || strings.HasPrefix(h.Name, "추석")
+}
+
+// 그 해의 공휴일 목록
+func InYear(year int) ([]Holiday, error) {
+	if !Covered(year) {
+		return nil, fmt.Errorf("공휴일 표(%s)에 %d년이 없습니다. %d~%d년만 지원합니다", Version, year, FirstYear, LastYear)
+	}
+	prefix := fmt.Sprintf("%d-", year)
+	list := []Holiday{}
+	for _, h := range table {
+		if strings.HasPrefix(h.Date, prefix) {
+			list = append(list, h)
+		}
+	}
+	return list, nil
+}
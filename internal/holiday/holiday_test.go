@@ -0,0 +1,63 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func date(s string) time.Time {
+	t, err := time.ParseInLocation(dateLayout, s, time.Local)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestLookup_LunarAndSubstitute(t *testing.T) {
+	h, ok := Lookup(date("2026-02-17"))
+	if !ok || h.Name != "설날" {
+		t.Fatalf("2026-02-17 설날을 기대했지만 %+v", h)
+	}
+	h, ok = Lookup(date("2026-03-02"))
+	if !ok || !h.Substitute {
+		t.Fatalf("2026-03-02 대체공휴일을 기대했지만 %+v", h)
+	}
+	if IsHoliday(date("2026-03-03")) {
+		t.Fatal("평일인데 공휴일로 나옴")
+	}
+}
+
+func TestIsMyeongjeol(t *testing.T) {
+	if !IsMyeongjeol(date("2025-10-08")) {
+		t.Fatal("추석 대체공휴일이 명절로 나오지 않음")
+	}
+	if IsMyeongjeol(date("2025-10-09")) {
+		t.Fatal("한글날이 명절로 나옴")
+	}
+}
+
+func TestLookup_OutsideTableUsesFixedHolidays(t *testing.T) {
+	if !IsHoliday(date("2030-08-15")) {
+		t.Fatal("표 밖의 연도에서도 광복절은 알아야 함")
+	}
+	if _, err := InYear(2030); err == nil {
+		t.Fatal("표에 없는 연도인데 에러가 발생하지 않음")
+	}
+}
+
+func TestTable_SortedAndValid(t *testing.T) {
+	prev := ""
+	for _, h := range table {
+		d, err := time.Parse(dateLayout, h.Date)
+		if err != nil {
+			t.Fatalf("잘못된 날짜: %s", h.Date)
+		}
+		if !Covered(d.Year()) {
+			t.Fatalf("표 범위 밖의 날짜: %s", h.Date)
+		}
+		if h.Date <= prev {
+			t.Fatalf("표가 날짜순이 아니거나 중복됨: %s", h.Date)
+		}
+		prev = h.Date
+	}
+}
@@ -0,0 +1,116 @@
+package holiday
+
+// 공휴일 표의 버전. 표를 고치면 올린다.
+const Version = "2026.1"
+
+// 표에 들어 있는 연도 범위
+const (
+	FirstYear = 2024
+	LastYear  = 2027
+)
+
+// 관공서의 공휴일에 관한 규정 기준의 공휴일 표
+// 설날, 추석, 부처님오신날은 음력 날짜를 양력으로 바꿔 적었고, 대체공휴일과 임시공휴일도 포함한다.
+var table = []Holiday{
+	// 2024
+	{Date: "2024-01-01", Name: "신정"},
+	{Date: "2024-02-09", Name: "설날 연휴"},
+	{Date: "2024-02-10", Name: "설날"},
+	{Date: "2024-02-11", Name: "설날 연휴"},
+	{Date: "2024-02-12", Name: "설날 대체공휴일", Substitute: true},
+	{Date: "2024-03-01", Name: "삼일절"},
+	{Date: "2024-04-10", Name: "국회의원 선거일"},
+	{Date: "2024-05-05", Name: "어린이날"},
+	{Date: "2024-05-06", Name: "어린이날 대체공휴일", Substitute: true},
+	{Date: "2024-05-15", Name: "부처님오신날"},
+	{Date: "2024-06-06", Name: "현충일"},
+	{Date: "2024-08-15", Name: "광복절"},
+	{Date: "2024-09-16", Name: "추석 연휴"},
+	{Date: "2024-09-17", Name: "추석"},
+	{Date: "2024-09-18", Name: "추석 연휴"},
+	{Date: "2024-10-01", Name: "국군의 날 임시공휴일"},
+	{Date: "2024-10-03", Name: "개천절"},
+	{Date: "2024-10-09", Name: "한글날"},
+	{Date: "2024-12-25", Name: "성탄절"},
+
+	// 2025
+	{Date: "2025-01-01", Name: "신정"},
+	{Date: "2025-01-27", Name: "임시공휴일"},
+	{Date: "2025-01-28", Name: "설날 연휴"},
+	{Date: "2025-01-29", Name: "설날"},
+	{Date: "2025-01-30", Name: "설날 연휴"},
+	{Date: "2025-03-01", Name: "삼일절"},
+	{Date: "2025-03-03", Name: "삼일절 대체공휴일", Substitute: true},
+	{Date: "2025-05-05", Name: "어린이날, 부처님오신날"},
+	{Date: "2025-05-06", Name: "대체공휴일", Substitute: true},
+	{Date: "2025-06-03", Name: "대통령 선거일"},
+	{Date: "2025-06-06", Name: "현충일"},
+	{Date: "2025-08-15", Name: "광복절"},
+	{Date: "2025-10-03", Name: "개천절"},
+	{Date: "2025-10-05", Name: "추석 연휴"},
+	{Date: "2025-10-06", Name: "추석"},
+	{Date: "2025-10-07", Name: "추석 연휴"},
+	{Date: "2025-10-08", Name: "추석 대체공휴일", Substitute: true},
+	{Date: "2025-10-09", Name: "한글날"},
+	{Date: "2025-12-25", Name: "성탄절"},
+
+	// 2026
+	{Date: "2026-01-01", Name: "신정"},
+	{Date: "2026-02-16", Name: "설날 연휴"},
+	{Date: "2026-02-17", Name: "설날"},
+	{Date: "2026-02-18", Name: "설날 연휴"},
+	{Date: "2026-03-01", Name: "삼일절"},
+	{Date: "2026-03-02", Name: "삼일절 대체공휴일", Substitute: true},
+	{Date: "2026-05-05", Name: "어린이날"},
+	{Date: "2026-05-24", Name: "부처님오신날"},
+	{Date: "2026-05-25", Name: "부처님오신날 대체공휴일", Substitute: true},
+	{Date: "2026-06-03", Name: "지방선거일"},
+	{Date: "2026-06-06", Name: "현충일"},
+	{Date: "2026-08-15", Name: "광복절"},
+	{Date: "2026-08-17", Name: "광복절 대체공휴일", Substitute: true},
+	{Date: "2026-09-24", Name: "추석 연휴"},
+	{Date: "2026-09-25", Name: "추석"},
+	{Date: "2026-09-26", Name: "추석 연휴"},
+	{Date: "2026-10-03", Name: "개천절"},
+	{Date: "2026-10-05", Name: "개천절 대체공휴일", Substitute: true},
+	{Date: "2026-10-09", Name: "한글날"},
+	{Date: "2026-12-25", Name: "성탄절"},
+
+	// 2027
+	{Date: "2027-01-01", Name: "신정"},
+	{Date: "2027-02-06", Name: "설날 연휴"},
+	{Date: "2027-02-07", Name: "설날"},
+	{Date: "2027-02-08", Name: "설날 연휴"},
+	{Date: "2027-02-09", Name: "설날 대체공휴일", Substitute: true},
+	{Date: "2027-03-01", Name: "삼일절"},
+	{Date: "2027-05-05", Name: "어린이날"},
+	{Date: "2027-05-13", Name: "부처님오신날"},
+	{Date: "2027-06-06", Name: "현충일"},
+	{Date: "2027-08-15", Name: "광복절"},
+	{Date: "2027-08-16", Name: "광복절 대체공휴일", Substitute: true},
+	{Date: "2027-09-14", Name: "추석 연휴"},
+	{Date: "2027-09-15", Name: "추석"},
+	{Date: "2027-09-16", Name: "추석 연휴"},
+	{Date: "2027-10-03", Name: "개천절"},
+	{Date: "2027-10-04", Name: "개천절 대체공휴일", Substitute: true},
+	{Date: "2027-10-09", Name: "한글날"},
+	{Date: "2027-10-11", Name: "한글날 대체공휴일", Substitute: true},
+	{Date: "2027-12-25", Name: "성탄절"},
+	{Date: "2027-12-27", Name: "성탄절 대체공휴일", Substitute: true},
+}
+
+// 표에 없는 연도에서 쓰는 양력 고정 공휴일
+// 음력 공휴일과 대체공휴일은 계산할 수 없으므로 빠진다.
+var fixed = []struct {
+	monthDay string
+	name     string
+}{
+	{"01-01", "신정"},
+	{"03-01", "삼일절"},
+	{"05-05", "어린이날"},
+	{"06-06", "현충일"},
+	{"08-15", "광복절"},
+	{"10-03", "개천절"},
+	{"10-09", "한글날"},
+	{"12-25", "성탄절"},
+}
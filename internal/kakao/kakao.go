@@ -0,0 +1,272 @@
+// kakao 패키지는 카카오 로컬 API로 장소를 검색하고 카카오맵 장소 URL을 다룬다.
+// 주소는 BaseURL, PlaceURL로 바꿀 수 있어 테스트나 로컬에서 대신 띄운 서버를 쓸 수 있다.
+package kakao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arch-spatula/jmc/internal/geo"
+)
+
+const (
+	// 카카오 로컬 API
+	DefaultBaseURL = "https://dapi.kakao.com"
+	// 카카오맵 장소 페이지. 장소 ID로 장소 정보를 조회할 때 쓴다.
+	DefaultPlaceURL = "https://place.map.kakao.com"
+)
+
+// 음식점, 카페 카테고리 그룹 코드
+const (
+	GroupRestaurant = "FD6"
+	GroupCafe       = "CE7"
+)
+
+var (
+	ErrNotFound = errors.New("카카오맵에서 장소를 찾을 수 없습니다")
+	ErrNoAPIKey = errors.New("카카오 REST API 키가 없습니다")
+)
+
+type Client struct {
+	BaseURL  string
+	PlaceURL string
+	// REST API 키. Authorization: KakaoAK <키> 헤더로 보낸다.
+	APIKey string
+	HTTP   *http.Client
+}
+
+func NewClient(apiKey string) *Client {
+	return &Client{
+		BaseURL:  DefaultBaseURL,
+		PlaceURL: DefaultPlaceURL,
+		APIKey:   apiKey,
+		HTTP:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// 카카오 로컬 API의 장소
+type Place struct {
+	ID   string `json:"id"`
+	Name string `json:"place_name"`
+	// "음식점 > 한식 > 국밥" 같은 분류 경로
+	Category      string `json:"category_name"`
+	CategoryGroup string `json:"category_group_code"`
+	Phone         string `json:"phone"`
+	Address       string `json:"address_name"`
+	RoadAddress   string `json:"road_address_name"`
+	// 경도, 위도
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	URL string `json:"place_url"`
+}
+
+// 장소의 좌표. 좌표가 없으면 false
+func (p *Place) Point() (geo.Point, bool) {
+	lng, errX := strconv.ParseFloat(p.X, 64)
+	lat, errY := strconv.ParseFloat(p.Y, 64)
+	if errX != nil || errY != nil {
+		return geo.Point{}, false
+	}
+	return geo.Point{Lat: lat, Lng: lng}, true
+}
+
+// 분류 경로에서 "음식점", "카페" 같은 최상위 그룹을 뺀 카테고리
+// 예: "음식점 > 한식 > 국밥" → [한식 국밥]
+func (p *Place) Categories() []string {
+	categories := []string{}
+	for i, part := range strings.Split(p.Category, ">") {
+		if part = strings.TrimSpace(part); part != "" && i > 0 {
+			categories = append(categories, part)
+		}
+	}
+	return categories
+}
+
+// 도로명 주소가 있으면 도로명 주소, 없으면 지번 주소
+func (p *Place) FullAddress() string {
+	if p.RoadAddress != "" {
+		return p.RoadAddress
+	}
+	return p.Address
+}
+
+type SearchOptions struct {
+	// 카테고리 그룹 코드 (예: GroupRestaurant). 비어 있으면 모든 장소
+	CategoryGroup string
+	// 한 번에 받을 결과 수 (1~15). 0이면 15개
+	Size int
+	// 중심 좌표와 반경(미터, 최대 20000). 중심이 있으면 가까운 순서로 받는다.
+	Center *geo.Point
+	Radius int
+}
+
+type apiError struct {
+	ErrorType string `json:"errorType"`
+	Message   string `json:"message"`
+}
+
+func (c *Client) get(ctx context.Context, endpoint string, auth bool, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	if auth {
+		req.Header.Set("Authorization", "KakaoAK "+c.APIKey)
+	}
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return fmt.Errorf("카카오 API 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("카카오 API 응답 읽기 실패: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr apiError
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Message != "" {
+			return fmt.Errorf("카카오 API 오류 (%d %s): %s", resp.StatusCode, apiErr.ErrorType, apiErr.Message)
+		}
+		return fmt.Errorf("카카오 API 오류: %s", resp.Status)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("카카오 API 응답 해석 실패: %w", err)
+	}
+	return nil
+}
+
+// 키워드로 장소를 검색함
+func (c *Client) Search(ctx context.Context, query string, opts SearchOptions) ([]Place, error) {
+	if c.APIKey == "" {
+		return nil, ErrNoAPIKey
+	}
+	params := url.Values{"query": {query}}
+	if opts.CategoryGroup != "" {
+		params.Set("category_group_code", opts.CategoryGroup)
+	}
+	if opts.Size > 0 {
+		params.Set("size", strconv.Itoa(opts.Size))
+	}
+	if opts.Center != nil {
+		params.Set("x", strconv.FormatFloat(opts.Center.Lng, 'f', -1, 64))
+		params.Set("y", strconv.FormatFloat(opts.Center.Lat, 'f', -1, 64))
+		params.Set("sort", "distance")
+		if opts.Radius > 0 {
+			params.Set("radius", strconv.Itoa(opts.Radius))
+		}
+	}
+
+	var resp struct {
+		Documents []Place `json:"documents"`
+	}
+	if err := c.get(ctx, strings.TrimSuffix(c.BaseURL, "/")+"/v2/local/search/keyword.json?"+params.Encode(), true, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Documents, nil
+}
+
+// 카카오맵 장소 페이지의 장소 정보 중 쓰는 부분
+type placeInfo struct {
+	BasicInfo *struct {
+		Name     string `json:"placenamefull"`
+		Phone    string `json:"phonenum"`
+		Category struct {
+			Name string `json:"catename"`
+		} `json:"category"`
+		Address struct {
+			Region struct {
+				Full string `json:"fullname"`
+			} `json:"region"`
+			Bunho string `json:"addrbunho"`
+		} `json:"address"`
+	} `json:"basicInfo"`
+}
+
+// 장소 ID로 장소를 조회함
+// 로컬 API에는 ID로 조회하는 기능이 없으므로 장소 페이지에서 이름을 알아낸 뒤 키워드 검색 결과에서 같은 ID를 찾는다.
+// 검색 결과에 없으면 좌표 없이 장소 페이지의 정보만 채워 반환한다.
+func (c *Client) Place(ctx context.Context, id string) (*Place, error) {
+	var info placeInfo
+	if err := c.get(ctx, strings.TrimSuffix(c.PlaceURL, "/")+"/main/v/"+url.PathEscape(id), false, &info); err != nil {
+		return nil, err
+	}
+	if info.BasicInfo == nil || info.BasicInfo.Name == "" {
+		return nil, ErrNotFound
+	}
+
+	places, err := c.Search(ctx, info.BasicInfo.Name, SearchOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range places {
+		if places[i].ID == id {
+			return &places[i], nil
+		}
+	}
+	b := info.BasicInfo
+	return &Place{
+		ID:       id,
+		Name:     b.Name,
+		Category: b.Category.Name,
+		Phone:    b.Phone,
+		Address:  strings.TrimSpace(b.Address.Region.Full + " " + b.Address.Bunho),
+		URL:      PlaceURL(id),
+	}, nil
+}
+
+var digits = regexp.MustCompile(`^[0-9]+$`)
+
+// 카카오맵 장소 URL이나 장소 ID에서 장소 ID를 꺼냄
+// place.map.kakao.com/<ID>, place.map.kakao.com/m/<ID>, map.kakao.com/link/map/<ID>,
+// map.kakao.com/?itemId=<ID>, m.map.kakao.com/actions/detailMapView?id=<ID>, kakaomap://place?id=<ID>를 받는다.
+func PlaceID(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if digits.MatchString(s) {
+		return s, true
+	}
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", false
+	}
+	// 다른 사이트의 id 쿼리를 장소 ID로 잘못 읽지 않도록 카카오맵 주소에서만 쿼리를 본다.
+	if u.Scheme == "kakaomap" || u.Host == "map.kakao.com" || u.Host == "m.map.kakao.com" {
+		for _, key := range []string{"itemId", "id"} {
+			if id := u.Query().Get(key); digits.MatchString(id) {
+				return id, true
+			}
+		}
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch {
+	case u.Host == "place.map.kakao.com" || u.Host == "m.place.map.kakao.com":
+		if id := segments[len(segments)-1]; digits.MatchString(id) {
+			return id, true
+		}
+	case u.Host == "map.kakao.com" && len(segments) >= 3 && segments[0] == "link":
+		if id := segments[2]; digits.MatchString(id) {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// 장소 ID의 정규 URL
+func PlaceURL(id string) string {
+	return DefaultPlaceURL + "/" + id
+}
@@ -0,0 +1,176 @@
+package kakao
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/arch-spatula/jmc/internal/geo"
+)
+
+// 카카오 로컬 API와 장소 페이지를 대신하는 서버
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	c := NewClient("test-key")
+	c.BaseURL = server.URL
+	c.PlaceURL = server.URL
+	return c
+}
+
+const searchBody = `{"documents":[
+	{"id":"26338954","place_name":"국밥집","category_name":"음식점 > 한식 > 국밥","category_group_code":"FD6",
+	 "address_name":"서울 강남구 역삼동 1","road_address_name":"서울 강남구 테헤란로 1","x":"127.02762","y":"37.49794",
+	 "place_url":"http://place.map.kakao.com/26338954"},
+	{"id":"111","place_name":"국밥집 2호점","category_name":"음식점 > 한식","x":"127.1","y":"37.5"}
+]}`
+
+func TestPlaceID(t *testing.T) {
+	cases := map[string]string{
+		"26338954":                                                  "26338954",
+		"https://place.map.kakao.com/26338954":                      "26338954",
+		"place.map.kakao.com/m/26338954":                            "26338954",
+		"https://map.kakao.com/link/map/26338954":                   "26338954",
+		"https://map.kakao.com/link/to/26338954":                    "26338954",
+		"https://map.kakao.com/?itemId=26338954":                    "26338954",
+		"https://m.map.kakao.com/actions/detailMapView?id=26338954": "26338954",
+		"kakaomap://place?id=26338954":                              "26338954",
+	}
+	for in, want := range cases {
+		if got, ok := PlaceID(in); !ok || got != want {
+			t.Errorf("%s: %s를 기대했지만 %q, %v", in, want, got, ok)
+		}
+	}
+	for _, in := range []string{
+		"", "국밥집", "https://example.com/26338954", "https://map.kakao.com/link/map/abc",
+		// 카카오맵이 아닌 주소의 id 쿼리는 장소 ID가 아님
+		"https://example.com/?id=123", "https://google.com/search?itemId=42", "https://evil.map.kakao.com.example.com/?id=1",
+	} {
+		if got, ok := PlaceID(in); ok {
+			t.Errorf("%q: 장소 ID가 아니어야 하지만 %s", in, got)
+		}
+	}
+}
+
+func TestPlaceCategories(t *testing.T) {
+	p := Place{Category: "음식점 > 한식 > 국밥"}
+	if got := strings.Join(p.Categories(), ","); got != "한식,국밥" {
+		t.Fatalf("한식,국밥을 기대했지만 %s", got)
+	}
+}
+
+func TestSearch_SendsKeyAndOptions(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/local/search/keyword.json" {
+			t.Errorf("잘못된 경로: %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "KakaoAK test-key" {
+			t.Errorf("잘못된 인증 헤더: %s", got)
+		}
+		q := r.URL.Query()
+		if q.Get("query") != "국밥" || q.Get("size") != "5" || q.Get("category_group_code") != GroupRestaurant {
+			t.Errorf("잘못된 검색 조건: %s", r.URL.RawQuery)
+		}
+		if q.Get("x") != "127.02762" || q.Get("y") != "37.49794" || q.Get("sort") != "distance" || q.Get("radius") != "500" {
+			t.Errorf("잘못된 중심 좌표: %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(searchBody))
+	})
+
+	center := geo.Point{Lat: 37.49794, Lng: 127.02762}
+	places, err := c.Search(context.Background(), "국밥", SearchOptions{CategoryGroup: GroupRestaurant, Size: 5, Center: &center, Radius: 500})
+	if err != nil {
+		t.Fatalf("검색 실패: %v", err)
+	}
+	if len(places) != 2 || places[0].Name != "국밥집" {
+		t.Fatalf("검색 결과 2개를 기대했지만 %+v", places)
+	}
+	p, ok := places[0].Point()
+	if !ok || p != center {
+		t.Fatalf("좌표 %v를 기대했지만 %v, %v", center, p, ok)
+	}
+	if got := places[0].FullAddress(); got != "서울 강남구 테헤란로 1" {
+		t.Fatalf("도로명 주소를 기대했지만 %s", got)
+	}
+}
+
+func TestSearch_NoAPIKey(t *testing.T) {
+	c := NewClient("")
+	if _, err := c.Search(context.Background(), "국밥", SearchOptions{}); !errors.Is(err, ErrNoAPIKey) {
+		t.Fatalf("ErrNoAPIKey를 기대했지만 %v", err)
+	}
+}
+
+func TestSearch_APIError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errorType":"AccessDeniedError","message":"wrong appKey"}`))
+	})
+	_, err := c.Search(context.Background(), "국밥", SearchOptions{})
+	if err == nil || !strings.Contains(err.Error(), "wrong appKey") {
+		t.Fatalf("API 오류 메시지를 기대했지만 %v", err)
+	}
+}
+
+func TestPlace_FindsCoordinates(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/main/v/26338954":
+			if r.Header.Get("Authorization") != "" {
+				t.Error("장소 페이지에 API 키를 보내면 안 됨")
+			}
+			w.Write([]byte(`{"basicInfo":{"placenamefull":"국밥집","category":{"catename":"국밥"}}}`))
+		case "/v2/local/search/keyword.json":
+			if q := r.URL.Query().Get("query"); q != "국밥집" {
+				t.Errorf("장소 이름으로 검색해야 하지만 %s", q)
+			}
+			w.Write([]byte(searchBody))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	p, err := c.Place(context.Background(), "26338954")
+	if err != nil {
+		t.Fatalf("장소 조회 실패: %v", err)
+	}
+	if p.ID != "26338954" || p.RoadAddress == "" {
+		t.Fatalf("검색 결과의 장소를 기대했지만 %+v", p)
+	}
+	if _, ok := p.Point(); !ok {
+		t.Fatal("좌표가 없음")
+	}
+}
+
+func TestPlace_NotInSearchResults(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/main/v/999" {
+			w.Write([]byte(`{"basicInfo":{"placenamefull":"국밥집","category":{"catename":"국밥"},
+				"address":{"region":{"fullname":"서울 강남구 역삼동"},"addrbunho":"1"}}}`))
+			return
+		}
+		w.Write([]byte(searchBody))
+	})
+
+	p, err := c.Place(context.Background(), "999")
+	if err != nil {
+		t.Fatalf("장소 조회 실패: %v", err)
+	}
+	if p.Name != "국밥집" || p.Address != "서울 강남구 역삼동 1" || p.URL != PlaceURL("999") {
+		t.Fatalf("장소 페이지 정보를 기대했지만 %+v", p)
+	}
+	if _, ok := p.Point(); ok {
+		t.Fatal("검색 결과에 없는 장소에 좌표가 있음")
+	}
+}
+
+func TestPlace_NotFound(t *testing.T) {
+	c := newTestClient(t, http.NotFound)
+	if _, err := c.Place(context.Background(), "1"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("ErrNotFound를 기대했지만 %v", err)
+	}
+}
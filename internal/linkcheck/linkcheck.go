@@ -0,0 +1,188 @@
+// linkcheck 패키지는 여러 URL을 동시에 열어 없어진 페이지와 폐업 페이지를 찾는다.
+package linkcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Status int
+
+const (
+	StatusOK Status = iota
+	// 404, 410
+	StatusNotFound
+	// 폐업 페이지로 리다이렉트됨
+	StatusClosed
+	// 재시도해도 응답을 받지 못했거나 알 수 없는 응답
+	StatusError
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusOK:
+		return "정상"
+	case StatusNotFound:
+		return "없는 페이지"
+	case StatusClosed:
+		return "폐업"
+	default:
+		return "오류"
+	}
+}
+
+// 폐업 페이지 주소의 경로 한 토막이나 쿼리 값
+var closedMarkers = []string{"폐업", "closed"}
+
+// 폐업 안내 페이지의 제목이나 안내 문구
+// 리뷰처럼 본문 어딘가에 "폐업"이라는 낱말이 있는 것만으로는 폐업으로 보지 않는다.
+var closedNotice = regexp.MustCompile(`<title>[^<]*폐업[^<]*</title>|폐업(한|된|하였거나|했거나) (장소|업체|가게)입니다`)
+
+// 폐업 여부를 확인할 때 읽는 본문의 최대 크기
+const maxBody = 256 << 10
+
+type Result struct {
+	URL    string
+	Status Status
+	// 마지막 응답의 상태 코드. 응답을 받지 못했으면 0
+	StatusCode int
+	// 리다이렉트된 뒤의 주소
+	FinalURL string
+	Attempts int
+	Err      error
+}
+
+// 죽은 링크인지 확인함. 일시적인 오류는 죽은 링크로 보지 않는다.
+func (r *Result) Broken() bool {
+	return r.Status == StatusNotFound || r.Status == StatusClosed
+}
+
+type Checker struct {
+	// 테스트에서 httptest 서버의 클라이언트로 바꿀 수 있다.
+	HTTP *http.Client
+	// 동시에 여는 요청 수
+	Workers int
+	// 요청 한 번의 제한 시간
+	Timeout time.Duration
+	// 네트워크 오류, 429, 5xx 응답일 때 다시 시도하는 횟수와 처음 기다리는 시간. 기다리는 시간은 시도할 때마다 두 배가 된다.
+	Retries int
+	Backoff time.Duration
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		HTTP:    &http.Client{},
+		Workers: 4,
+		Timeout: 10 * time.Second,
+		Retries: 2,
+		Backoff: 500 * time.Millisecond,
+	}
+}
+
+// urls를 Workers개씩 동시에 확인함. 결과는 urls와 같은 순서다.
+func (c *Checker) CheckAll(ctx context.Context, urls []string) []Result {
+	results := make([]Result, len(urls))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range max(c.Workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = c.Check(ctx, urls[i])
+			}
+		}()
+	}
+	for i := range urls {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
+
+// URL 하나를 확인함. 일시적인 오류면 Retries번까지 다시 시도한다.
+func (c *Checker) Check(ctx context.Context, link string) Result {
+	result := Result{URL: link}
+	wait := c.Backoff
+	for {
+		result.Attempts++
+		retry := c.try(ctx, &result)
+		if !retry || result.Attempts > c.Retries {
+			return result
+		}
+		select {
+		case <-ctx.Done():
+			result.Err = ctx.Err()
+			return result
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+// 한 번 요청해 result를 채우고 다시 시도할 만한 오류인지 반환함
+func (c *Checker) try(ctx context.Context, result *Result) bool {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+	*result = Result{URL: result.URL, Attempts: result.Attempts, Status: StatusError}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, result.URL, nil)
+	if err != nil {
+		result.Err = err
+		return false
+	}
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		result.Err = err
+		// 전체 작업이 취소되었으면 다시 시도하지 않음
+		return !errors.Is(ctx.Err(), context.Canceled)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBody))
+	if err != nil {
+		result.Err = err
+		return true
+	}
+	result.StatusCode = resp.StatusCode
+	result.FinalURL = resp.Request.URL.String()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		result.Status = StatusNotFound
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		result.Err = fmt.Errorf("응답 %s", resp.Status)
+		return true
+	case resp.StatusCode != http.StatusOK:
+		result.Err = fmt.Errorf("응답 %s", resp.Status)
+	case result.FinalURL != result.URL && closedPage(resp.Request.URL, body):
+		result.Status = StatusClosed
+	default:
+		result.Status = StatusOK
+	}
+	return false
+}
+
+// 리다이렉트된 페이지가 폐업 페이지인지 확인함
+func closedPage(u *url.URL, body []byte) bool {
+	values := strings.Split(u.Path, "/")
+	for _, list := range u.Query() {
+		values = append(values, list...)
+	}
+	for _, v := range values {
+		for _, marker := range closedMarkers {
+			if strings.EqualFold(v, marker) {
+				return true
+			}
+		}
+	}
+	return closedNotice.Match(body)
+}
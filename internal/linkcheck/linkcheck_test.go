@@ -0,0 +1,175 @@
+package linkcheck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestChecker(server *httptest.Server) *Checker {
+	c := NewChecker()
+	c.HTTP = server.Client()
+	c.Timeout = time.Second
+	c.Backoff = time.Millisecond
+	return c
+}
+
+func TestCheck_Statuses(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<title>국밥집</title>"))
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusFound)
+	})
+	mux.HandleFunc("/closed", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/place/closed", http.StatusFound)
+	})
+	mux.HandleFunc("/place/closed", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("안내"))
+	})
+	mux.HandleFunc("/closed-body", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/notice", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/notice", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<p>폐업한 장소입니다</p>"))
+	})
+	mux.HandleFunc("/query", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok?status=CLOSED", http.StatusFound)
+	})
+	// 리다이렉트된 정상 페이지의 본문이나 주소에 폐업이라는 낱말이 있어도 폐업이 아님
+	mux.HandleFunc("/review", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/review/", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/review/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<title>국밥집</title><p>옆 가게가 폐업해서 여기로 왔어요</p>"))
+	})
+	mux.HandleFunc("/enclosed", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/enclosed/garden?note=not-closed", http.StatusFound)
+	})
+	mux.HandleFunc("/enclosed/garden", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("/gone", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	c := newTestChecker(server)
+
+	cases := map[string]Status{
+		"/ok":          StatusOK,
+		"/moved":       StatusOK,
+		"/closed":      StatusClosed,
+		"/closed-body": StatusClosed,
+		"/query":       StatusClosed,
+		"/review":      StatusOK,
+		"/enclosed":    StatusOK,
+		"/missing":     StatusNotFound,
+		"/gone":        StatusNotFound,
+	}
+	for path, want := range cases {
+		r := c.Check(context.Background(), server.URL+path)
+		if r.Status != want {
+			t.Errorf("%s: %s를 기대했지만 %s (%v)", path, want, r.Status, r.Err)
+		}
+		if r.Attempts != 1 {
+			t.Errorf("%s: 한 번만 요청해야 하지만 %d번", path, r.Attempts)
+		}
+	}
+}
+
+func TestCheck_RetriesServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	r := newTestChecker(server).Check(context.Background(), server.URL)
+	if r.Status != StatusOK || r.Attempts != 3 {
+		t.Fatalf("세 번째 시도에서 정상을 기대했지만 %s, %d번 (%v)", r.Status, r.Attempts, r.Err)
+	}
+}
+
+func TestCheck_GivesUpAfterRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := newTestChecker(server)
+	c.Retries = 1
+	r := c.Check(context.Background(), server.URL)
+	if r.Status != StatusError || r.Attempts != 2 || r.Broken() {
+		t.Fatalf("두 번 시도 후 확인 실패를 기대했지만 %s, %d번", r.Status, r.Attempts)
+	}
+}
+
+func TestCheck_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	c := newTestChecker(server)
+	c.Timeout = 20 * time.Millisecond
+	c.Retries = 0
+	r := c.Check(context.Background(), server.URL)
+	if r.Status != StatusError || r.Err == nil {
+		t.Fatalf("시간 초과 오류를 기대했지만 %s, %v", r.Status, r.Err)
+	}
+}
+
+func TestCheckAll_BoundedWorkers(t *testing.T) {
+	var running, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		running.Add(-1)
+		if r.URL.Path == "/3" {
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestChecker(server)
+	c.Workers = 2
+	urls := []string{}
+	for _, path := range []string{"/0", "/1", "/2", "/3", "/4", "/5"} {
+		urls = append(urls, server.URL+path)
+	}
+	results := c.CheckAll(context.Background(), urls)
+	if len(results) != len(urls) {
+		t.Fatalf("결과 %d개를 기대했지만 %d개", len(urls), len(results))
+	}
+	for i, r := range results {
+		if r.URL != urls[i] {
+			t.Fatalf("%d번째 결과의 순서가 다름: %s", i, r.URL)
+		}
+		if want := i == 3; r.Broken() != want {
+			t.Errorf("%s: 죽은 링크 여부 %v를 기대했지만 %s", r.URL, want, r.Status)
+		}
+	}
+	if p := peak.Load(); p > 2 {
+		t.Fatalf("동시 요청은 2개까지여야 하지만 %d개", p)
+	}
+}
@@ -0,0 +1,124 @@
+package restaurant
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// 추천 전략
+const (
+	// 모든 식당을 같은 확률로 추천
+	StrategyRandom = "random"
+	// 모드의 점수 요인에 비례해 추천 (기본값)
+	StrategyWeighted = "weighted"
+	// 방문 평점을 사후분포로 보고 톰슨 샘플링으로 추천
+	// 평점이 적은 새 식당은 분산이 커서 탐색되고, 평점이 좋은 식당은 자주 뽑힌다.
+	StrategyBandit = "bandit"
+)
+
+var strategies = []string{StrategyRandom, StrategyWeighted, StrategyBandit}
+
+// 모드의 전략에 따라 후보를 n개 고름
+func selectCandidates(data *RestaurantData, mode *Mode, now time.Time, cond conditions, r *rand.Rand, n int) []Candidate {
+	switch mode.Strategy {
+	case StrategyRandom:
+		// 점수 요인은 모두 빼지만 같은 카테고리를 피하는 규칙은 그대로 지킨다.
+		uniform := &Mode{Name: mode.Name, Strategy: StrategyRandom, Diversity: Diversity{Rules: mode.Diversity.Rules}}
+		return pickWeighted(r, scoreCandidates(data, uniform, now, cond), n)
+	case StrategyBandit:
+		return pickTop(sampleBandit(data, mode, now, cond, r), n)
+	default:
+		return pickWeighted(r, scoreCandidates(data, mode, now, cond), n)
+	}
+}
+
+// 식당별 방문 평점. 평점을 남기지 않은 방문(0점)은 제외한다.
+func (d *RestaurantData) visitRatings() map[string][]float64 {
+	ratings := make(map[string][]float64)
+	for _, v := range d.Visits {
+		if v.Rating > 0 {
+			ratings[v.Restaurant] = append(ratings[v.Restaurant], v.Rating)
+		}
+	}
+	return ratings
+}
+
+// 방문 평점을 0~1 사이의 성공률로 보고 Beta 사후분포의 모수를 구함
+// 사전분포는 Beta(1, 1)이다.
+func betaPosterior(ratings []float64) (alpha, beta float64) {
+	alpha, beta = 1, 1
+	for _, rating := range ratings {
+		p := rating / 5
+		alpha += p
+		beta += 1 - p
+	}
+	return alpha, beta
+}
+
+// 톰슨 샘플링으로 점수를 매김
+// 평점 대신 사후분포에서 뽑은 값을 5점 만점으로 환산해 더한다. 쿨다운 등 나머지 요인은 모드를 따른다.
+func sampleBandit(data *RestaurantData, mode *Mode, now time.Time, cond conditions, r *rand.Rand) []Candidate {
+	base := *mode
+	base.RatingWeight = 0
+	base.NoveltyBonus = 0
+	candidates := scoreCandidates(data, &base, now, cond)
+
+	ratings := data.visitRatings()
+	for i := range candidates {
+		if candidates[i].Explain.Excluded != "" {
+			continue
+		}
+		list := ratings[candidates[i].Name]
+		alpha, beta := betaPosterior(list)
+		sample := sampleBeta(r, alpha, beta) * 5
+		candidates[i].add("bandit", sample, fmt.Sprintf("방문 평점 %d개, 사후분포 Beta(%.1f, %.1f)에서 %.2f", len(list), alpha, beta, sample))
+		// 샘플링 결과에 따라 순위가 정해지므로 선택 확률은 계산하지 않음
+		candidates[i].Explain.Probability = 0
+	}
+	return candidates
+}
+
+// 점수가 높은 순서로 n개를 고름. 점수가 0 이하인 후보는 제외한다.
+func pickTop(candidates []Candidate, n int) []Candidate {
+	sorted := make([]Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		if c.weight() > 0 {
+			sorted = append(sorted, c)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Explain.Score > sorted[j].Explain.Score
+	})
+	if n > len(sorted) {
+		n = len(sorted)
+	}
+	return sorted[:n]
+}
+
+// Beta(alpha, beta) 분포에서 표본을 뽑음
+func sampleBeta(r *rand.Rand, alpha, beta float64) float64 {
+	x := sampleGamma(r, alpha)
+	y := sampleGamma(r, beta)
+	return x / (x + y)
+}
+
+// Marsaglia-Tsang 방법으로 Gamma(shape, 1) 분포에서 표본을 뽑음 (shape >= 1)
+func sampleGamma(r *rand.Rand, shape float64) float64 {
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := r.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+		u := r.Float64()
+		if math.Log(u) < 0.5*x*x+d-d*v+d*math.Log(v) {
+			return d * v
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package restaurant
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestBetaPosterior(t *testing.T) {
+	alpha, beta := betaPosterior([]float64{5, 2.5})
+	if alpha != 2.5 || beta != 1.5 {
+		t.Fatalf("Beta(2.5, 1.5)를 기대했지만 Beta(%v, %v)", alpha, beta)
+	}
+}
+
+func TestSampleBeta_InRange(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sum := 0.0
+	for i := 0; i < 2000; i++ {
+		x := sampleBeta(r, 9, 1)
+		if x < 0 || x > 1 {
+			t.Fatalf("0~1 범위를 벗어남: %v", x)
+		}
+		sum += x
+	}
+	// Beta(9, 1)의 평균은 0.9
+	if mean := sum / 2000; mean < 0.87 || mean > 0.93 {
+		t.Fatalf("평균 0.9 근처를 기대했지만 %v", mean)
+	}
+}
+
+func TestSelectCandidates_BanditPrefersWellRated(t *testing.T) {
+	data := &RestaurantData{
+		Restaurants: testRestaurants("맛집", "별로"),
+		Visits:      []Visit{},
+	}
+	for i := 0; i < 10; i++ {
+		data.Visits = append(data.Visits,
+			Visit{Restaurant: "맛집", Date: "2026-01-01", Rating: 5},
+			Visit{Restaurant: "별로", Date: "2026-01-01", Rating: 1},
+		)
+	}
+	mode := &Mode{Name: "밴딧", Strategy: StrategyBandit}
+	r := rand.New(rand.NewSource(1))
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local)
+
+	wins := 0
+	for i := 0; i < 100; i++ {
+		if picks := selectCandidates(data, mode, now, conditions{}, r, 1); picks[0].Name == "맛집" {
+			wins++
+		}
+	}
+	if wins < 95 {
+		t.Fatalf("평점이 좋은 식당을 대부분 골라야 하지만 %d/100", wins)
+	}
+}
+
+func TestSimulate_ComparesAllStrategies(t *testing.T) {
+	data := &RestaurantData{
+		Restaurants: testRestaurants("a", "b", "c"),
+		Visits: []Visit{
+			{Restaurant: "a", Date: "2026-01-01", Rating: 5},
+			{Restaurant: "b", Date: "2026-01-02", Rating: 2},
+		},
+	}
+	results, err := Simulate(data, SimulateOptions{Steps: 20, Seed: 1})
+	if err != nil {
+		t.Fatalf("시뮬레이션 실패: %v", err)
+	}
+	if len(results) != len(strategies) {
+		t.Fatalf("전략 %d개의 결과를 기대했지만 %d개", len(strategies), len(results))
+	}
+	for _, r := range results {
+		if r.Steps != 20 || r.Regret < 0 || r.Distinct == 0 {
+			t.Fatalf("잘못된 시뮬레이션 결과: %+v", r)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package restaurant
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// 카테고리 분류
+// 한식 > 국밥처럼 상위 카테고리 아래에 하위 카테고리를 둔다. 상위 카테고리로 거르면 하위 카테고리도 맞는다.
+type Category = Term
+
+func restaurantCategories(r *Restaurant) []string {
+	return r.Categories
+}
+
+// 카테고리 분류에 식당에 붙어 있는 태그를 더한 색인
+// 분류에 없는 태그도 대소문자나 공백만 다르면 먼저 쓰인 표기로 맞춘다.
+func (d *RestaurantData) categoryIndex() termIndex {
+	idx := buildTermIndex(d.Categories)
+	for _, rest := range d.Restaurants {
+		for _, c := range rest.Categories {
+			key := termKey(c)
+			if _, ok := idx[key]; !ok && key != "" {
+				idx[key] = []string{cleanTerm(c)}
+			}
+		}
+	}
+	return idx
+}
+
+// 식당의 카테고리를 분류에 맞춰 정리함
+func (d *RestaurantData) normalizeCategories(r *Restaurant) {
+	r.Categories = d.categoryIndex().normalize(r.Categories)
+}
+
+// 저장하기 전에 식당의 위치와 카테고리를 정리함
+func (d *RestaurantData) normalizeTags(r *Restaurant) {
+	d.normalizeLocations(r)
+	d.normalizeCategories(r)
+}
+
+type CategoryTree struct {
+	Categories []TermNode `json:"categories"`
+	// 카테고리 분류에 없는 태그
+	Others []TermNode `json:"others"`
+}
+
+// 카테고리 분류와 카테고리별 식당 수
+func (s *Service) Categories() (*CategoryTree, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	idx := buildTermIndex(data.Categories)
+	return &CategoryTree{
+		Categories: countTerms(data.Categories, idx, data.Restaurants, restaurantCategories),
+		Others:     otherTerms(idx, data.Restaurants, restaurantCategories),
+	}, nil
+}
+
+// 위키 태그 입력의 자동완성 목록. 분류 순서 다음에 분류에 없는 태그가 온다.
+func (d *RestaurantData) CategoryNames() []string {
+	names := []string{}
+	var walk func(terms []Term)
+	walk = func(terms []Term) {
+		for _, term := range terms {
+			names = append(names, term.Name)
+			walk(term.Children)
+		}
+	}
+	walk(d.Categories)
+	for _, other := range otherTerms(buildTermIndex(d.Categories), d.Restaurants, restaurantCategories) {
+		names = append(names, other.Name)
+	}
+	return names
+}
+
+// 자동완성 후보
+type CategorySuggestion struct {
+	Name string `json:"name"`
+	// 최상위 카테고리부터의 경로. 분류에 없는 태그는 자기 이름만 있다.
+	Path []string `json:"path"`
+	// 이 카테고리(하위 카테고리 포함)가 붙은 식당 수
+	Count int `json:"count"`
+}
+
+// prefix로 시작하는 이름이나 별칭을 가진 카테고리를 식당 수가 많은 순서로 limit개까지 반환함
+// limit이 0 이하면 모두 반환한다.
+func (s *Service) SuggestCategories(prefix string, limit int) ([]CategorySuggestion, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	idx := data.categoryIndex()
+	prefix = termKey(prefix)
+
+	suggestions := []CategorySuggestion{}
+	seen := map[string]bool{}
+	for key, path := range idx {
+		name := path[len(path)-1]
+		if !strings.HasPrefix(key, prefix) || seen[name] {
+			continue
+		}
+		seen[name] = true
+		count := 0
+		for _, rest := range data.Restaurants {
+			if idx.matchesAny(rest.Categories, []string{name}) {
+				count++
+			}
+		}
+		suggestions = append(suggestions, CategorySuggestion{Name: name, Path: path, Count: count})
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Count != suggestions[j].Count {
+			return suggestions[i].Count > suggestions[j].Count
+		}
+		return suggestions[i].Name < suggestions[j].Name
+	})
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// 분류에서 key를 이름이나 별칭으로 가진 항목
+func findTerm(terms []Term, key string) *Term {
+	for i := range terms {
+		if termKey(terms[i].Name) == key || containsKey(terms[i].Aliases, key) {
+			return &terms[i]
+		}
+		if found := findTerm(terms[i].Children, key); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// 분류에서 key를 이름으로 가진 항목을 빼냄
+func removeTerm(terms *[]Term, key string) (Term, bool) {
+	for i := range *terms {
+		if termKey((*terms)[i].Name) == key {
+			term := (*terms)[i]
+			*terms = append((*terms)[:i], (*terms)[i+1:]...)
+			return term, true
+		}
+		if term, ok := removeTerm(&(*terms)[i].Children, key); ok {
+			return term, true
+		}
+	}
+	return Term{}, false
+}
+
+func containsKey(list []string, key string) bool {
+	for _, s := range list {
+		if termKey(s) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// 목록에서 from 중 하나와 같은 태그를 to로 바꾸고 중복을 뺌
+func replaceTags(list []string, from []string, to string) ([]string, bool) {
+	replaced := make([]string, 0, len(list))
+	changed := false
+	for _, tag := range list {
+		if containsKey(from, termKey(tag)) {
+			changed = changed || tag != to
+			tag = to
+		}
+		if !contains(replaced, tag) {
+			replaced = append(replaced, tag)
+		}
+	}
+	return replaced, changed
+}
+
+// 모든 식당의 from 태그를 to로 바꾸고 바뀐 식당 수를 반환함
+// 검색 필터와 모드 규칙의 카테고리도 함께 바꾼다.
+// from이 분류에 있으면 to 항목으로 합친다. to가 분류에 없으면 from 항목의 이름을 to로 바꾼다.
+// 합쳐진 이름은 to의 별칭이 되어 나중에 입력해도 to로 정리된다.
+func (s *Service) RenameTags(from []string, to string) (int, error) {
+	to = cleanTerm(to)
+	if to == "" || len(from) == 0 {
+		return 0, fmt.Errorf("%w: 바꿀 태그와 새 이름이 필요합니다", ErrInvalid)
+	}
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return 0, err
+	}
+	toKey := termKey(to)
+	idx := buildTermIndex(data.Categories)
+
+	// 별칭으로 지정해도 분류의 항목 전체(이름과 모든 별칭)를 바꿈
+	keys := []string{}
+	for _, f := range from {
+		keys = append(keys, termKey(f))
+		if path, ok := idx[termKey(f)]; ok {
+			node := findTerm(data.Categories, termKey(path[len(path)-1]))
+			keys = append(keys, termKey(node.Name))
+			for _, alias := range node.Aliases {
+				keys = append(keys, termKey(alias))
+			}
+		}
+	}
+
+	for _, key := range keys {
+		node := findTerm(data.Categories, key)
+		if node == nil || termKey(node.Name) != key {
+			continue
+		}
+		target := findTerm(data.Categories, toKey)
+		if target == nil || target == node {
+			// 이름만 바꿈
+			if termKey(node.Name) != toKey {
+				node.Aliases = append(node.Aliases, node.Name)
+			}
+			node.Name = to
+			continue
+		}
+		if contains(idx[toKey], node.Name) {
+			return 0, fmt.Errorf("%w: %s를 자신의 하위 카테고리 %s로 합칠 수 없습니다", ErrInvalid, node.Name, to)
+		}
+		merged, _ := removeTerm(&data.Categories, key)
+		target = findTerm(data.Categories, toKey)
+		target.Aliases = append(target.Aliases, merged.Name)
+		target.Aliases = append(target.Aliases, merged.Aliases...)
+		target.Children = append(target.Children, merged.Children...)
+	}
+	removeAlias(data.Categories, toKey)
+	if err := validateTerms(data.Categories, map[string]bool{}); err != nil {
+		return 0, fmt.Errorf("%w: 카테고리 분류를 바꿀 수 없습니다: %v", ErrInvalid, err)
+	}
+
+	changed := 0
+	for i := range data.Restaurants {
+		var ok bool
+		if data.Restaurants[i].Categories, ok = replaceTags(data.Restaurants[i].Categories, keys, to); ok {
+			changed++
+		}
+	}
+	// 별칭으로 남아 있던 태그도 바뀐 분류에 맞춰 정리함
+	for i := range data.Restaurants {
+		data.normalizeCategories(&data.Restaurants[i])
+	}
+	for i := range data.Search.Filters {
+		data.Search.Filters[i].Categories, _ = replaceTags(data.Search.Filters[i].Categories, keys, to)
+	}
+	for i := range data.CLIConfig.Modes {
+		mode := &data.CLIConfig.Modes[i]
+		for j := range mode.Rules {
+			mode.Rules[j].Categories, _ = replaceTags(mode.Rules[j].Categories, keys, to)
+		}
+		for j := range mode.Diversity.Rules {
+			if containsKey(keys, termKey(mode.Diversity.Rules[j].Category)) {
+				mode.Diversity.Rules[j].Category = to
+			}
+		}
+	}
+
+	if err := s.repo.Save(data); err != nil {
+		return 0, err
+	}
+	return changed, nil
+}
+
+// 이름이 key인 항목의 별칭에서 key를 뺌 (이름과 별칭이 같아지지 않게)
+func removeAlias(terms []Term, key string) {
+	for i := range terms {
+		if termKey(terms[i].Name) == key {
+			aliases := []string{}
+			for _, alias := range terms[i].Aliases {
+				if termKey(alias) != key {
+					aliases = append(aliases, alias)
+				}
+			}
+			terms[i].Aliases = aliases
+		}
+		removeAlias(terms[i].Children, key)
+	}
+}
@@ -0,0 +1,120 @@
+package restaurant
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func categoryTestData() *RestaurantData {
+	list := testRestaurants("국밥집", "백반집", "초밥집", "스시집")
+	list[0].Categories = []string{"국밥"}
+	list[1].Categories = []string{"한식"}
+	list[2].Categories = []string{"Japanese"}
+	list[3].Categories = []string{"japanese ", "스시"}
+	return &RestaurantData{
+		Restaurants: list,
+		Categories: []Category{
+			{Name: "한식", Aliases: []string{"korean"}, Children: []Category{{Name: "국밥"}}},
+			{Name: "일식", Children: []Category{{Name: "초밥", Aliases: []string{"스시"}}}},
+		},
+	}
+}
+
+func TestRepositoryCreate_NormalizesCategories(t *testing.T) {
+	s := newTestService(t, categoryTestData(), time.Time{})
+	item := testRestaurants("새 식당")[0]
+	// "한식"을 조합형 자모로 풀어쓴 값과 대소문자만 다른 별칭
+	item.Categories = []string{"한식", " KOREAN", "JAPANESE"}
+	if err := s.Create(item); err != nil {
+		t.Fatalf("추가 실패: %v", err)
+	}
+	data, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("데이터 읽기 실패: %v", err)
+	}
+	got := data.Restaurants[len(data.Restaurants)-1].Categories
+	if !sameNames(got, []string{"한식", "Japanese"}) {
+		t.Fatalf("[한식 Japanese]를 기대했지만 %q", got)
+	}
+}
+
+func TestServiceSuggestCategories(t *testing.T) {
+	s := newTestService(t, categoryTestData(), time.Time{})
+
+	got, err := s.SuggestCategories("한", 10)
+	if err != nil {
+		t.Fatalf("자동완성 실패: %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "한식" || got[0].Count != 2 {
+		t.Fatalf("하위 카테고리를 포함해 한식 2곳을 기대했지만 %+v", got)
+	}
+
+	// 별칭으로 찾아도 원래 이름을 제안함
+	got, err = s.SuggestCategories("스", 10)
+	if err != nil {
+		t.Fatalf("자동완성 실패: %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "초밥" || len(got[0].Path) != 2 {
+		t.Fatalf("초밥을 기대했지만 %+v", got)
+	}
+}
+
+func TestServiceRenameTags_MergeIntoRegistered(t *testing.T) {
+	s := newTestService(t, categoryTestData(), time.Time{})
+
+	changed, err := s.RenameTags([]string{"Japanese"}, "초밥")
+	if err != nil {
+		t.Fatalf("태그 합치기 실패: %v", err)
+	}
+	if changed != 2 {
+		t.Fatalf("식당 2곳을 기대했지만 %d", changed)
+	}
+	data, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("데이터 읽기 실패: %v", err)
+	}
+	if got := data.Restaurants[3].Categories; len(got) != 1 || got[0] != "초밥" {
+		t.Fatalf("스시집 태그가 초밥 하나로 합쳐지지 않음: %q", got)
+	}
+}
+
+func TestServiceRenameTags_RenamesRegistry(t *testing.T) {
+	s := newTestService(t, categoryTestData(), time.Time{})
+
+	if _, err := s.RenameTags([]string{"일식"}, "일본식"); err != nil {
+		t.Fatalf("태그 이름 바꾸기 실패: %v", err)
+	}
+	if _, err := s.RenameTags([]string{"국밥"}, "한식"); err != nil {
+		t.Fatalf("하위 카테고리를 상위로 합치기 실패: %v", err)
+	}
+	data, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("데이터 읽기 실패: %v", err)
+	}
+	japanese := data.Categories[1]
+	if japanese.Name != "일본식" || !contains(japanese.Aliases, "일식") {
+		t.Fatalf("이전 이름이 별칭으로 남아야 함: %+v", japanese)
+	}
+	korean := data.Categories[0]
+	if len(korean.Children) != 0 || !contains(korean.Aliases, "국밥") {
+		t.Fatalf("국밥이 한식의 별칭으로 합쳐져야 함: %+v", korean)
+	}
+
+	if _, err := s.RenameTags([]string{"일본식"}, "초밥"); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("하위 카테고리로 합치면 ErrInvalid를 기대했지만 %v", err)
+	}
+}
+
+func TestSearchFilterMatches_ParentCategory(t *testing.T) {
+	filter, err := (&SearchFilter{Categories: []string{"korean"}}).compile()
+	if err != nil {
+		t.Fatalf("필터 해석 실패: %v", err)
+	}
+	rest := testRestaurants("국밥집")[0]
+	rest.Categories = []string{"국밥"}
+	idx := buildTermIndex([]Category{{Name: "한식", Aliases: []string{"korean"}, Children: []Category{{Name: "국밥"}}}})
+	if !filter.matches(&queryContext{regions: termIndex{}, categories: idx}, &rest) {
+		t.Fatal("상위 카테고리 필터에 하위 카테고리 식당이 맞지 않음")
+	}
+}
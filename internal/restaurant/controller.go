@@ -3,9 +3,12 @@ package restaurant
 import (
 	"embed"
 	"encoding/json"
+	"errors"
 	"html/template"
+	"io"
 	"io/fs"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -20,11 +23,30 @@ func NewController(service *Service, wikiFiles embed.FS) *Controller {
 			escaped := template.HTMLEscapeString(s)
 			return template.HTML(strings.ReplaceAll(escaped, "\n", "<br>"))
 		},
+		"price": FormatPrice,
+		// 첫 번째 기준 위치에서 식당까지의 거리. 알 수 없으면 빈 문자열
+		"walk": func(cfg CLIConfig, r Restaurant) string {
+			origin, err := cfg.FindOrigin("")
+			if err != nil {
+				return ""
+			}
+			walk, ok := cfg.WalkFrom(origin, &r)
+			if !ok {
+				return ""
+			}
+			return walk.String()
+		},
 	}
 	tmpl, _ := template.New("index.html").Funcs(funcMap).ParseFS(wikiFiles, "wiki/index.html")
 	return &Controller{service: service, tmpl: tmpl}
 }
 
+// 위키 화면에 넘기는 데이터. Query가 있으면 검색 결과만 보여준다.
+type pageData struct {
+	*RestaurantData
+	Query string
+}
+
 func (c *Controller) HandlePage(w http.ResponseWriter, r *http.Request) {
 	data, err := c.service.GetAll()
 	if err != nil {
@@ -32,19 +54,313 @@ func (c *Controller) HandlePage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query != "" {
+		results, err := c.service.Search(query, 0)
+		if err != nil {
+			http.Error(w, err.Error(), httpStatus(err))
+			return
+		}
+		data.Restaurants = make([]Restaurant, 0, len(results))
+		for _, result := range results {
+			data.Restaurants = append(data.Restaurants, result.Restaurant)
+		}
+	}
+
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	c.tmpl.Execute(w, data)
+	c.tmpl.Execute(w, pageData{RestaurantData: data, Query: query})
 }
 
-func (c *Controller) HandleGetAll(w http.ResponseWriter, r *http.Request) {
-	data, err := c.service.GetAll()
+// 식당 목록
+// q(검색식), filter(저장된 검색 필터 이름), sort, order, from, limit, cursor로 조회 조건을 정한다.
+func (c *Controller) HandleList(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	opts := PageOptions{
+		Query:  params.Get("q"),
+		Filter: params.Get("filter"),
+		Sort:   params.Get("sort"),
+		Order:  params.Get("order"),
+		From:   params.Get("from"),
+		Cursor: params.Get("cursor"),
+	}
+	if limitParam := params.Get("limit"); limitParam != "" {
+		n, err := strconv.Atoi(limitParam)
+		if err != nil || n <= 0 {
+			http.Error(w, "limit은 1 이상의 정수여야 합니다", http.StatusBadRequest)
+			return
+		}
+		opts.Limit = n
+	}
+
+	page, err := c.service.Page(opts)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), httpStatus(err))
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(data)
+	json.NewEncoder(w).Encode(page)
+}
+
+func (c *Controller) HandleGet(w http.ResponseWriter, r *http.Request) {
+	item, err := c.service.Get(r.PathValue("name"))
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(item)
+}
+
+func (c *Controller) HandleMenus(w http.ResponseWriter, r *http.Request) {
+	menus, err := c.service.Menus(r.PathValue("name"))
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(menus)
+}
+
+// 주문할 메뉴 추천
+// mode(usual, new), budget, limit(기본 3개), for(식단 프로필, 쉼표로 구분)로 조건을 정한다.
+func (c *Controller) HandleRecommendMenu(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	opts := OrderOptions{Mode: params.Get("mode"), Count: 3, Profiles: profilesParam(r)}
+	if budgetParam := params.Get("budget"); budgetParam != "" {
+		budget, err := strconv.Atoi(budgetParam)
+		if err != nil || budget < 0 {
+			http.Error(w, "budget은 0 이상의 정수여야 합니다", http.StatusBadRequest)
+			return
+		}
+		opts.Budget = budget
+	}
+	if limitParam := params.Get("limit"); limitParam != "" {
+		n, err := strconv.Atoi(limitParam)
+		if err != nil || n <= 0 {
+			http.Error(w, "limit은 1 이상의 정수여야 합니다", http.StatusBadRequest)
+			return
+		}
+		opts.Count = n
+	}
+
+	picks, err := c.service.PickMenu(r.PathValue("name"), opts)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(picks)
+}
+
+func (c *Controller) HandleMenuPrices(w http.ResponseWriter, r *http.Request) {
+	history, err := c.service.MenuPrices(r.PathValue("name"), r.PathValue("menu"))
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(history)
+}
+
+// 최근 days일(기본 90일) 동안 가격이 많이 오른 메뉴를 limit개(기본 10개)까지 응답한다.
+func (c *Controller) HandlePriceIncreases(w http.ResponseWriter, r *http.Request) {
+	days, limit := 90, 10
+	if daysParam := r.URL.Query().Get("days"); daysParam != "" {
+		n, err := strconv.Atoi(daysParam)
+		if err != nil || n <= 0 {
+			http.Error(w, "days는 1 이상의 정수여야 합니다", http.StatusBadRequest)
+			return
+		}
+		days = n
+	}
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		n, err := strconv.Atoi(limitParam)
+		if err != nil || n < 0 {
+			http.Error(w, "limit은 0 이상의 정수여야 합니다", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+
+	increases, err := c.service.PriceIncreases(days, limit)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(increases)
+}
+
+// 한 달 식비 현황. month(YYYY-MM)가 없으면 이번 달
+func (c *Controller) HandleSpending(w http.ResponseWriter, r *http.Request) {
+	spending, err := c.service.Spending(r.URL.Query().Get("month"))
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(spending)
+}
+
+// 지금까지의 잔액과 정산 방법
+func (c *Controller) HandleSettlement(w http.ResponseWriter, r *http.Request) {
+	settlement, err := c.service.Settlement()
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(settlement)
+}
+
+func (c *Controller) HandleCreateBill(w http.ResponseWriter, r *http.Request) {
+	var bill Bill
+	if err := json.NewDecoder(r.Body).Decode(&bill); err != nil {
+		http.Error(w, "잘못된 요청입니다", http.StatusBadRequest)
+		return
+	}
+
+	created, err := c.service.AddBill(bill)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(struct {
+		*Bill
+		Shares []Share `json:"shares"`
+	}{created, created.Shares()})
+}
+
+func (c *Controller) HandleCreatePayment(w http.ResponseWriter, r *http.Request) {
+	var payment Transfer
+	if err := json.NewDecoder(r.Body).Decode(&payment); err != nil {
+		http.Error(w, "잘못된 요청입니다", http.StatusBadRequest)
+		return
+	}
+
+	created, err := c.service.AddPayment(payment)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+func (c *Controller) HandleProfiles(w http.ResponseWriter, r *http.Request) {
+	profiles, err := c.service.Profiles()
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(profiles)
+}
+
+// 식단 프로필을 추가하거나 바꿈. 이름은 경로의 이름을 쓴다.
+func (c *Controller) HandleSetProfile(w http.ResponseWriter, r *http.Request) {
+	var profile DietProfile
+	if err := json.NewDecoder(r.Body).Decode(&profile); err != nil {
+		http.Error(w, "잘못된 요청입니다", http.StatusBadRequest)
+		return
+	}
+	profile.Name = r.PathValue("name")
+
+	saved, err := c.service.SetProfile(profile)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(saved)
+}
+
+func (c *Controller) HandleDeleteProfile(w http.ResponseWriter, r *http.Request) {
+	if err := c.service.DeleteProfile(r.PathValue("name")); err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *Controller) HandleCreateMenu(w http.ResponseWriter, r *http.Request) {
+	var menu Menu
+	if err := json.NewDecoder(r.Body).Decode(&menu); err != nil {
+		http.Error(w, "잘못된 요청입니다", http.StatusBadRequest)
+		return
+	}
+
+	created, err := c.service.AddMenu(r.PathValue("name"), menu)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// 메뉴의 일부 필드만 바꿈. 본문에 없는 필드는 그대로 둔다.
+func (c *Controller) HandleUpdateMenu(w http.ResponseWriter, r *http.Request) {
+	var patch MenuPatch
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		http.Error(w, "잘못된 요청입니다", http.StatusBadRequest)
+		return
+	}
+
+	menu, err := c.service.UpdateMenu(r.PathValue("name"), r.PathValue("menu"), patch)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(menu)
+}
+
+func (c *Controller) HandleDeleteMenu(w http.ResponseWriter, r *http.Request) {
+	if err := c.service.DeleteMenu(r.PathValue("name"), r.PathValue("menu")); err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// 메뉴 순서를 바꿈. 본문은 {"names": ["메뉴", ...]}
+func (c *Controller) HandleReorderMenus(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Names []string `json:"names"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "잘못된 요청입니다", http.StatusBadRequest)
+		return
+	}
+
+	menus, err := c.service.ReorderMenus(r.PathValue("name"), req.Names)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(menus)
 }
 
 func (c *Controller) HandleCreate(w http.ResponseWriter, r *http.Request) {
@@ -93,19 +409,254 @@ func (c *Controller) HandleDelete(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// GET 요청이므로 직전 추천을 기록하지 않음
 func (c *Controller) HandleRecommend(w http.ResponseWriter, r *http.Request) {
-	restaurant, err := c.service.Recommend()
+	c.recommend(w, r, RecommendOptions{Preview: true})
+}
+
+// 직전 추천을 거절하고 다시 추천함
+func (c *Controller) HandleReroll(w http.ResponseWriter, r *http.Request) {
+	c.recommend(w, r, RecommendOptions{Reroll: true})
+}
+
+// 팀원 모두에게 같은 오늘의 추천. GET 요청이므로 직전 추천을 기록하지 않는다.
+func (c *Controller) HandleToday(w http.ResponseWriter, r *http.Request) {
+	c.recommend(w, r, RecommendOptions{Daily: true, Preview: true})
+}
+
+// count가 없으면 식당 하나(또는 null)를, 있으면 배열을 응답함
+// 각 식당에는 추천 이유가 담긴 explain 필드가 붙는다.
+func (c *Controller) recommend(w http.ResponseWriter, r *http.Request, opts RecommendOptions) {
+	opts.Count = 1
+	countParam := r.URL.Query().Get("count")
+	if countParam != "" {
+		count, err := strconv.Atoi(countParam)
+		if err != nil || count <= 0 {
+			http.Error(w, "count는 1 이상의 정수여야 합니다", http.StatusBadRequest)
+			return
+		}
+		opts.Count = count
+	}
+	opts.Mode = r.URL.Query().Get("mode")
+	if atParam := r.URL.Query().Get("at"); atParam != "" {
+		at, err := ParseArrival(atParam, c.service.Now())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		opts.At = &at
+	}
+	opts.From = r.URL.Query().Get("from")
+	opts.Region = r.URL.Query().Get("region")
+	opts.Query = r.URL.Query().Get("q")
+	opts.Profiles = profilesParam(r)
+	if budgetParam := r.URL.Query().Get("budget"); budgetParam != "" {
+		budget, err := strconv.Atoi(budgetParam)
+		if err != nil || budget < 0 {
+			http.Error(w, "budget은 0 이상의 정수여야 합니다", http.StatusBadRequest)
+			return
+		}
+		opts.Budget = budget
+	}
+	if walkParam := r.URL.Query().Get("max_walk"); walkParam != "" {
+		maxWalk, err := ParseMaxWalk(walkParam)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		opts.MaxWalk = maxWalk
+	}
+	if seedParam := r.URL.Query().Get("seed"); seedParam != "" {
+		seed, err := strconv.ParseInt(seedParam, 10, 64)
+		if err != nil {
+			http.Error(w, "seed는 정수여야 합니다", http.StatusBadRequest)
+			return
+		}
+		opts.Seed = &seed
+	}
+
+	picks, err := c.service.Recommend(opts)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if countParam != "" {
+		json.NewEncoder(w).Encode(picks)
 		return
 	}
-	if restaurant == nil {
-		w.Header().Set("Content-Type", "application/json")
+	if len(picks) == 0 {
 		w.Write([]byte("null"))
 		return
 	}
+	json.NewEncoder(w).Encode(picks[0])
+}
+
+// 지역 선택기에 쓰는 지역 분류와 지역별 식당 수
+func (c *Controller) HandleLocations(w http.ResponseWriter, r *http.Request) {
+	tree, err := c.service.Locations()
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tree)
+}
+
+// 식당 검색. 초성, 오타, 로마자 검색을 지원하고 점수가 높은 순서로 limit개(기본 20개)까지 응답한다.
+func (c *Controller) HandleSearch(w http.ResponseWriter, r *http.Request) {
+	limit := 20
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		n, err := strconv.Atoi(limitParam)
+		if err != nil || n < 0 {
+			http.Error(w, "limit은 0 이상의 정수여야 합니다", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+
+	results, err := c.service.Search(r.URL.Query().Get("q"), limit)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(results)
+}
+
+// 태그 자동완성
+// prefix로 시작하는 카테고리를 식당 수가 많은 순서로 limit개(기본 10개)까지 응답한다.
+func (c *Controller) HandleCategories(w http.ResponseWriter, r *http.Request) {
+	limit := 10
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		n, err := strconv.Atoi(limitParam)
+		if err != nil || n < 0 {
+			http.Error(w, "limit은 0 이상의 정수여야 합니다", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+
+	suggestions, err := c.service.SuggestCategories(r.URL.Query().Get("prefix"), limit)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(suggestions)
+}
+
+// 추천받은 식당에 다녀왔음을 기록함
+// 본문은 생략할 수 있고 menus, spend, rating을 담을 수 있다.
+func (c *Controller) HandleAccept(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+
+	var visit Visit
+	if err := json.NewDecoder(r.Body).Decode(&visit); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "잘못된 요청입니다", http.StatusBadRequest)
+		return
+	}
+
+	saved, err := c.service.Accept(name, visit)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(saved)
+}
+
+// 저장된 점심 계획. 없으면 null
+func (c *Controller) HandleGetPlan(w http.ResponseWriter, r *http.Request) {
+	plan, err := c.service.GetPlan()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(plan)
+}
+
+func (c *Controller) HandleCreatePlan(w http.ResponseWriter, r *http.Request) {
+	var opts PlanOptions
+	if err := json.NewDecoder(r.Body).Decode(&opts); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "잘못된 요청입니다", http.StatusBadRequest)
+		return
+	}
+
+	plan, err := c.service.NewPlan(opts)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(plan)
+}
+
+// 계획의 하루를 다시 뽑음. 본문에 kind를 주면 그날의 종류도 바꾼다.
+func (c *Controller) HandleRerollPlanDay(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Kind *string `json:"kind"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "잘못된 요청입니다", http.StatusBadRequest)
+		return
+	}
+
+	plan, err := c.service.RerollPlanDay(r.PathValue("day"), req.Kind)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(restaurant)
+	json.NewEncoder(w).Encode(plan)
+}
+
+func (c *Controller) HandlePlanICS(w http.ResponseWriter, r *http.Request) {
+	plan, err := c.service.GetPlan()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if plan == nil {
+		http.Error(w, "점심 계획이 없습니다", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="lunch-`+plan.Week+`.ics"`)
+	w.Write([]byte(plan.ICS(c.service.Now())))
+}
+
+// for 쿼리 파라미터. 쉼표로 구분한 식단 프로필 이름
+func profilesParam(r *http.Request) []string {
+	profiles := []string{}
+	for _, name := range strings.Split(r.URL.Query().Get("for"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			profiles = append(profiles, name)
+		}
+	}
+	return profiles
+}
+
+// 서비스 에러를 HTTP 상태 코드로 변환함
+func httpStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrNotFound), errors.Is(err, ErrMenuNotFound), errors.Is(err, ErrProfileNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrInvalid):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
 }
 
 func (c *Controller) HandleSave(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,482 @@
+package restaurant
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/arch-spatula/jmc/internal/geo"
+)
+
+// CSV 열로 가져오고 내보낼 수 있는 필드
+// menu로 시작하는 필드는 메뉴 하나를 뜻하고, 같은 식당의 행이 여러 개면 메뉴가 차례로 붙는다.
+var CSVFields = []string{
+	"name", "rating", "categories", "locations", "kakao_url", "visited", "description", "address", "lat", "lng",
+	"menu", "menu_price", "menu_rating", "menu_description",
+}
+
+// 필드와 무관한 열로 표시하는 매핑 값
+const csvIgnore = "-"
+
+// 매핑을 주지 않았을 때 머리글을 필드로 알아보는 이름
+var csvAliases = map[string]string{
+	"이름": "name", "상호": "name", "식당": "name", "restaurant": "name",
+	"평점": "rating", "별점": "rating",
+	"카테고리": "categories", "분류": "categories", "종류": "categories", "category": "categories",
+	"위치": "locations", "지역": "locations", "location": "locations",
+	"카카오맵": "kakao_url", "링크": "kakao_url", "url": "kakao_url",
+	"방문": "visited", "가봄": "visited",
+	"설명": "description", "메모": "description",
+	"주소": "address", "위도": "lat", "경도": "lng",
+	"메뉴": "menu", "가격": "menu_price", "메뉴 가격": "menu_price", "메뉴 평점": "menu_rating", "메뉴 설명": "menu_description",
+}
+
+// "상호=name, 분류=categories, 비고=-" 같은 머리글과 필드의 대응을 해석함
+func ParseCSVMapping(s string) (map[string]string, error) {
+	mapping := map[string]string{}
+	for _, part := range strings.Split(s, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		header, field, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: 매핑은 머리글=필드 형식이어야 합니다: %s", ErrInvalid, strings.TrimSpace(part))
+		}
+		mapping[strings.TrimSpace(header)] = strings.TrimSpace(field)
+	}
+	return mapping, nil
+}
+
+type ImportOptions struct {
+	// CSV 머리글 -> 필드(CSVFields 중 하나, 무시하려면 "-")
+	// 매핑에 없는 머리글은 필드 이름이나 별칭(이름, 평점, 카테고리 등)과 같으면 그 필드로 본다.
+	Mapping map[string]string
+	// categories, locations의 여러 값을 나누는 문자들. 비어 있으면 ",;"
+	Separators string
+	// 검사만 하고 저장하지 않음
+	DryRun bool
+	// 이미 있는 식당을 CSV에 있는 필드로 바꿈. false면 건너뛴다.
+	Update bool
+	// 오류가 있는 식당을 빼고 나머지를 저장함. false면 오류가 하나라도 있으면 아무것도 저장하지 않는다.
+	SkipErrors bool
+}
+
+// 행 하나의 오류
+type RowError struct {
+	// 메뉴 파일의 행이면 true
+	Menus   bool   `json:"menus,omitempty"`
+	Line    int    `json:"line"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+func (e RowError) Error() string {
+	file := ""
+	if e.Menus {
+		file = "메뉴 파일 "
+	}
+	if e.Name == "" {
+		return fmt.Sprintf("%s%d행: %s", file, e.Line, e.Message)
+	}
+	return fmt.Sprintf("%s%d행 %s: %s", file, e.Line, e.Name, e.Message)
+}
+
+type ImportResult struct {
+	New     []Restaurant `json:"new"`
+	Updated []Restaurant `json:"updated"`
+	// 이미 있어서 건너뛴 식당 (ImportOptions.Update가 false일 때)
+	Skipped []string   `json:"skipped"`
+	Errors  []RowError `json:"errors"`
+	// 어느 필드에도 대응하지 않아 무시한 열
+	Ignored []string `json:"ignored"`
+	Saved   bool     `json:"saved"`
+}
+
+// CSV에서 읽은 식당 하나
+type importEntry struct {
+	line int
+	rest Restaurant
+	// 데이터에 이미 있는 식당
+	existing bool
+	// CSV에서 메뉴를 하나라도 읽었으면 기존 메뉴 대신 CSV의 메뉴를 쓴다.
+	csvMenus bool
+	failed   bool
+}
+
+type csvImport struct {
+	data       *RestaurantData
+	mapping    map[string]string
+	separators string
+	entries    map[string]*importEntry
+	order      []string
+	result     *ImportResult
+}
+
+func (imp *csvImport) fail(entry *importEntry, menus bool, line int, name string, err error) {
+	if entry != nil {
+		entry.failed = true
+	}
+	imp.result.Errors = append(imp.result.Errors, RowError{Menus: menus, Line: line, Name: name, Message: err.Error()})
+}
+
+// 머리글마다 대응하는 필드. 대응하지 않는 열은 빈 문자열이다.
+func (imp *csvImport) columns(header []string) ([]string, error) {
+	fields := make([]string, len(header))
+	seen := map[string]string{}
+	for i, h := range header {
+		h = cleanTerm(strings.TrimPrefix(h, "\ufeff"))
+		field, ok := imp.mapping[termKey(h)]
+		if !ok {
+			key := termKey(h)
+			if contains(CSVFields, key) {
+				field = key
+			} else {
+				field = csvAliases[key]
+			}
+		}
+		if field == "" || field == csvIgnore {
+			if h != "" && !contains(imp.result.Ignored, h) {
+				imp.result.Ignored = append(imp.result.Ignored, h)
+			}
+			continue
+		}
+		if prev, ok := seen[field]; ok {
+			return nil, fmt.Errorf("%w: %s 열과 %s 열이 모두 %s 필드입니다", ErrInvalid, prev, h, field)
+		}
+		seen[field] = h
+		fields[i] = field
+	}
+	if _, ok := seen["name"]; !ok {
+		return nil, fmt.Errorf("%w: 식당 이름(name) 열이 없습니다. 머리글: %s", ErrInvalid, strings.Join(header, ", "))
+	}
+	return fields, nil
+}
+
+// CSV를 읽어 식당마다 모음. menus면 메뉴만 담은 파일이다.
+func (imp *csvImport) read(in io.Reader, menus bool) error {
+	reader := csv.NewReader(in)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: CSV가 비어 있습니다", ErrInvalid)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: CSV 읽기 실패: %w", ErrInvalid, err)
+	}
+	fields, err := imp.columns(header)
+	if err != nil {
+		return err
+	}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: CSV 읽기 실패: %w", ErrInvalid, err)
+		}
+		line, _ := reader.FieldPos(0)
+		values := map[string]string{}
+		blank := true
+		for i, v := range record {
+			if i < len(fields) && fields[i] != "" {
+				values[fields[i]] = strings.TrimSpace(v)
+				blank = blank && values[fields[i]] == ""
+			}
+		}
+		if blank {
+			continue
+		}
+		imp.readRow(values, menus, line)
+	}
+}
+
+func (imp *csvImport) readRow(values map[string]string, menus bool, line int) {
+	name := cleanTerm(values["name"])
+	if name == "" {
+		imp.fail(nil, menus, line, "", fmt.Errorf("식당 이름이 비어 있습니다"))
+		return
+	}
+	entry := imp.entries[name]
+	switch {
+	case menus && entry == nil:
+		imp.fail(nil, menus, line, name, fmt.Errorf("식당 CSV에 없는 식당입니다"))
+		return
+	case menus && values["menu"] == "":
+		imp.fail(entry, menus, line, name, fmt.Errorf("메뉴 이름이 비어 있습니다"))
+		return
+	case !menus && entry != nil && values["menu"] == "":
+		imp.fail(nil, menus, line, name, fmt.Errorf("%d행과 이름이 중복됩니다", entry.line))
+		return
+	case entry == nil:
+		entry = &importEntry{line: line, rest: Restaurant{Name: name}}
+		if prev, err := imp.data.findRestaurant(name); err == nil {
+			entry.rest = *prev
+			entry.rest.Menus = append([]Menu(nil), prev.Menus...)
+			entry.existing = true
+		}
+		imp.entries[name] = entry
+		imp.order = append(imp.order, name)
+		if err := imp.applyFields(&entry.rest, values); err != nil {
+			imp.fail(entry, menus, line, name, err)
+			return
+		}
+	}
+
+	if values["menu"] == "" {
+		return
+	}
+	menu, err := parseCSVMenu(values)
+	if err != nil {
+		imp.fail(entry, menus, line, name, err)
+		return
+	}
+	if !entry.csvMenus {
+		entry.rest.Menus = nil
+		entry.csvMenus = true
+	}
+	entry.rest.Menus = append(entry.rest.Menus, menu)
+}
+
+// 값이 있는 식당 필드만 바꿈. 빈 칸은 기존 값을 유지한다.
+func (imp *csvImport) applyFields(r *Restaurant, values map[string]string) error {
+	if v := values["rating"]; v != "" {
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("rating은 숫자여야 합니다: %s", v)
+		}
+		r.Rating = n
+	}
+	if v := values["categories"]; v != "" {
+		r.Categories = imp.split(v)
+	}
+	if v := values["locations"]; v != "" {
+		r.Locations = imp.split(v)
+	}
+	if v := values["kakao_url"]; v != "" {
+		r.KakaoURL = v
+	}
+	if v := values["description"]; v != "" {
+		r.Description = v
+	}
+	if v := values["address"]; v != "" {
+		r.Address = v
+	}
+	if v := values["visited"]; v != "" {
+		visited, err := parseCSVBool(v)
+		if err != nil {
+			return err
+		}
+		r.Visited = visited
+	}
+	lat, lng := values["lat"], values["lng"]
+	if lat != "" || lng != "" {
+		latN, latErr := strconv.ParseFloat(lat, 64)
+		lngN, lngErr := strconv.ParseFloat(lng, 64)
+		if latErr != nil || lngErr != nil {
+			return fmt.Errorf("lat, lng는 둘 다 숫자여야 합니다: %q, %q", lat, lng)
+		}
+		r.Coord = &geo.Point{Lat: latN, Lng: lngN}
+	}
+	return nil
+}
+
+func (imp *csvImport) split(s string) []string {
+	list := []string{}
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return strings.ContainsRune(imp.separators, r) }) {
+		if part = cleanTerm(part); part != "" && !contains(list, part) {
+			list = append(list, part)
+		}
+	}
+	return list
+}
+
+func parseCSVBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "true", "1", "y", "yes", "o", "예", "방문":
+		return true, nil
+	case "false", "0", "n", "no", "x", "아니오":
+		return false, nil
+	}
+	return false, fmt.Errorf("visited는 true/false, o/x, 예/아니오 중 하나여야 합니다: %s", s)
+}
+
+// "9,000원" 같은 가격도 받음
+func parseCSVPrice(s string) (int, error) {
+	s = strings.TrimSuffix(strings.ReplaceAll(s, ",", ""), "원")
+	return strconv.Atoi(strings.TrimSpace(s))
+}
+
+func parseCSVMenu(values map[string]string) (Menu, error) {
+	m := Menu{Name: values["menu"], Description: values["menu_description"]}
+	if v := values["menu_price"]; v != "" {
+		price, err := parseCSVPrice(v)
+		if err != nil {
+			return m, fmt.Errorf("menu_price는 숫자여야 합니다: %s", v)
+		}
+		m.Price = price
+	}
+	if v := values["menu_rating"]; v != "" {
+		rating, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return m, fmt.Errorf("menu_rating은 숫자여야 합니다: %s", v)
+		}
+		m.Rating = rating
+	}
+	return m, nil
+}
+
+// CSV의 식당을 가져옴
+// menus가 nil이 아니면 식당 이름(name 또는 restaurant 열)과 메뉴 열만 있는 메뉴 파일을 함께 읽는다.
+// 모든 식당을 Restaurant.Validate로 검사하고 오류는 행마다 ImportResult.Errors에 담는다.
+func (s *Service) ImportCSV(in io.Reader, menus io.Reader, opts ImportOptions) (*ImportResult, error) {
+	imp := &csvImport{
+		mapping:    map[string]string{},
+		separators: opts.Separators,
+		entries:    map[string]*importEntry{},
+		result:     &ImportResult{New: []Restaurant{}, Updated: []Restaurant{}, Skipped: []string{}, Errors: []RowError{}, Ignored: []string{}},
+	}
+	if imp.separators == "" {
+		imp.separators = ",;"
+	}
+	for header, field := range opts.Mapping {
+		if field != csvIgnore && !contains(CSVFields, field) {
+			return nil, fmt.Errorf("%w: 알 수 없는 필드입니다: %s=%s (%s)", ErrInvalid, header, field, strings.Join(CSVFields, ", "))
+		}
+		imp.mapping[termKey(header)] = field
+	}
+
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	imp.data = data
+	if err := imp.read(in, false); err != nil {
+		return nil, err
+	}
+	if menus != nil {
+		if err := imp.read(menus, true); err != nil {
+			return nil, err
+		}
+	}
+
+	result := imp.result
+	req := SaveRequest{}
+	for _, name := range imp.order {
+		entry := imp.entries[name]
+		if entry.failed {
+			continue
+		}
+		normalizeRestaurant(&entry.rest)
+		if err := entry.rest.Validate(); err != nil {
+			imp.fail(entry, false, entry.line, name, err)
+			continue
+		}
+		switch {
+		case !entry.existing:
+			req.New = append(req.New, entry.rest)
+			result.New = append(result.New, entry.rest)
+		case opts.Update:
+			req.Update = append(req.Update, entry.rest)
+			result.Updated = append(result.Updated, entry.rest)
+		default:
+			result.Skipped = append(result.Skipped, name)
+		}
+	}
+
+	if opts.DryRun || (len(result.Errors) > 0 && !opts.SkipErrors) {
+		return result, nil
+	}
+	if len(req.New) == 0 && len(req.Update) == 0 {
+		return result, nil
+	}
+	if _, err := s.repo.SaveBatch(req, s.today()); err != nil {
+		return nil, err
+	}
+	result.Saved = true
+	return result, nil
+}
+
+var csvRestaurantHeader = []string{"name", "rating", "categories", "locations", "kakao_url", "visited", "description", "address", "lat", "lng"}
+var csvMenuHeader = []string{"menu", "menu_price", "menu_rating", "menu_description"}
+
+func csvRestaurantRecord(r *Restaurant) []string {
+	lat, lng := "", ""
+	if r.Coord != nil {
+		lat = strconv.FormatFloat(r.Coord.Lat, 'f', -1, 64)
+		lng = strconv.FormatFloat(r.Coord.Lng, 'f', -1, 64)
+	}
+	return []string{
+		r.Name, strconv.FormatFloat(r.Rating, 'f', -1, 64), strings.Join(r.Categories, ", "), strings.Join(r.Locations, ", "),
+		r.KakaoURL, strconv.FormatBool(r.Visited), r.Description, r.Address, lat, lng,
+	}
+}
+
+func csvMenuRecord(m *Menu) []string {
+	return []string{m.Name, strconv.Itoa(m.Price), strconv.FormatFloat(m.Rating, 'f', -1, 64), m.Description}
+}
+
+// 식당 목록을 CSV로 내보냄
+// menus가 nil이면 메뉴마다 한 행씩 식당 열을 되풀이해 펼치고, 아니면 메뉴를 menus에 따로 쓴다.
+// 내보낸 CSV는 ImportCSV로 그대로 다시 가져올 수 있다.
+func (s *Service) ExportCSV(w io.Writer, menus io.Writer) error {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return err
+	}
+
+	out := csv.NewWriter(w)
+	var menuOut *csv.Writer
+	header := csvRestaurantHeader
+	if menus == nil {
+		header = append(append([]string{}, csvRestaurantHeader...), csvMenuHeader...)
+	} else {
+		menuOut = csv.NewWriter(menus)
+		if err := menuOut.Write(append([]string{"restaurant"}, csvMenuHeader...)); err != nil {
+			return err
+		}
+	}
+	if err := out.Write(header); err != nil {
+		return err
+	}
+
+	for i := range data.Restaurants {
+		r := &data.Restaurants[i]
+		record := csvRestaurantRecord(r)
+		if menuOut != nil {
+			if err := out.Write(record); err != nil {
+				return err
+			}
+			for j := range r.Menus {
+				if err := menuOut.Write(append([]string{r.Name}, csvMenuRecord(&r.Menus[j])...)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if len(r.Menus) == 0 {
+			if err := out.Write(append(record, make([]string, len(csvMenuHeader))...)); err != nil {
+				return err
+			}
+		}
+		for j := range r.Menus {
+			if err := out.Write(append(append([]string{}, record...), csvMenuRecord(&r.Menus[j])...)); err != nil {
+				return err
+			}
+		}
+	}
+
+	out.Flush()
+	if err := out.Error(); err != nil {
+		return err
+	}
+	if menuOut != nil {
+		menuOut.Flush()
+		return menuOut.Error()
+	}
+	return nil
+}
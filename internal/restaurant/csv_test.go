@@ -0,0 +1,124 @@
+package restaurant
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func csvTestData() *RestaurantData {
+	data := orderTestData()
+	data.Restaurants[0].Categories = []string{"한식"}
+	data.Restaurants[0].Diet = &Diet{Spicy: 1}
+	return data
+}
+
+const sheetCSV = "\ufeff상호,분류,위치,별점,비고,메뉴,가격\n" +
+	"스시집,일식; 초밥,강남역,4.5,회식 추천,모둠초밥,\"18,000원\"\n" +
+	"스시집,,,,,연어덮밥,13000\n" +
+	"라멘집,일식,\"강남역, 역삼역\",4,,,\n" +
+	"\n" +
+	"분식집,분식,,6,,,\n" +
+	"국밥집,한식,강남역,3.5,,순대국,9500\n"
+
+func TestServiceImportCSV_DryRunAndErrors(t *testing.T) {
+	s := newTestService(t, csvTestData(), time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local))
+	mapping, err := ParseCSVMapping("비고=description")
+	if err != nil {
+		t.Fatalf("매핑 해석 실패: %v", err)
+	}
+	result, err := s.ImportCSV(strings.NewReader(sheetCSV), nil, ImportOptions{Mapping: mapping, DryRun: true})
+	if err != nil {
+		t.Fatalf("가져오기 실패: %v", err)
+	}
+	if len(result.New) != 2 || len(result.Skipped) != 1 || len(result.Errors) != 1 || result.Saved {
+		t.Fatalf("결과가 다름: %+v", result)
+	}
+	sushi := result.New[0]
+	if sushi.Name != "스시집" || len(sushi.Categories) != 2 || sushi.Categories[1] != "초밥" || sushi.Description != "회식 추천" {
+		t.Fatalf("스시집을 잘못 읽음: %+v", sushi)
+	}
+	if len(sushi.Menus) != 2 || sushi.Menus[0].Price != 18000 || sushi.Menus[1].Name != "연어덮밥" {
+		t.Fatalf("스시집 메뉴를 잘못 읽음: %+v", sushi.Menus)
+	}
+	if got := result.New[1].Locations; len(got) != 2 || got[1] != "역삼역" {
+		t.Fatalf("여러 위치를 나누지 못함: %q", got)
+	}
+	// 빈 행을 건너뛰어도 줄 번호는 파일의 줄 번호
+	if e := result.Errors[0]; e.Line != 6 || e.Name != "분식집" {
+		t.Fatalf("오류 위치가 다름: %+v", e)
+	}
+
+	// 오류가 있으면 아무것도 저장하지 않음
+	if result, err = s.ImportCSV(strings.NewReader(sheetCSV), nil, ImportOptions{Mapping: mapping}); err != nil || result.Saved {
+		t.Fatalf("오류가 있는데 저장함: %+v, %v", result, err)
+	}
+	if _, err := s.Get("스시집"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("미리보기나 실패한 가져오기가 저장됨: %v", err)
+	}
+
+	result, err = s.ImportCSV(strings.NewReader(sheetCSV), nil, ImportOptions{Mapping: mapping, SkipErrors: true, Update: true})
+	if err != nil || !result.Saved || len(result.Updated) != 1 {
+		t.Fatalf("오류를 건너뛴 가져오기 실패: %+v, %v", result, err)
+	}
+	gukbap, err := s.Get("국밥집")
+	if err != nil {
+		t.Fatalf("국밥집 조회 실패: %v", err)
+	}
+	// CSV에 없는 필드는 그대로 두고, 가격 변화는 기록함
+	if gukbap.Diet == nil || len(gukbap.Menus) != 1 || gukbap.Menus[0].Price != 9500 || len(gukbap.Menus[0].History) != 1 {
+		t.Fatalf("기존 식당을 잘못 바꿈: %+v", gukbap)
+	}
+}
+
+func TestServiceImportCSV_Header(t *testing.T) {
+	s := newTestService(t, csvTestData(), time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local))
+	cases := []struct {
+		csv     string
+		mapping map[string]string
+	}{
+		{"평점,분류\n4,한식\n", nil},
+		{"상호,이름\na,b\n", nil},
+		{"상호\na\n", map[string]string{"상호": "title"}},
+		{"", nil},
+	}
+	for _, tc := range cases {
+		if _, err := s.ImportCSV(strings.NewReader(tc.csv), nil, ImportOptions{Mapping: tc.mapping}); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%q: ErrInvalid가 아님: %v", tc.csv, err)
+		}
+	}
+}
+
+func TestServiceExportCSV_RoundTrip(t *testing.T) {
+	s := newTestService(t, csvTestData(), time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local))
+	for _, separate := range []bool{false, true} {
+		var out, menus bytes.Buffer
+		var menusIn *bytes.Buffer
+		if separate {
+			if err := s.ExportCSV(&out, &menus); err != nil {
+				t.Fatalf("내보내기 실패: %v", err)
+			}
+			menusIn = &menus
+		} else if err := s.ExportCSV(&out, nil); err != nil {
+			t.Fatalf("내보내기 실패: %v", err)
+		}
+
+		opts := ImportOptions{DryRun: true, Update: true}
+		var result *ImportResult
+		var err error
+		if menusIn != nil {
+			result, err = s.ImportCSV(&out, menusIn, opts)
+		} else {
+			result, err = s.ImportCSV(&out, nil, opts)
+		}
+		if err != nil || len(result.Errors) > 0 || len(result.Updated) != 1 {
+			t.Fatalf("내보낸 CSV를 다시 가져오지 못함 (메뉴 파일 %v): %+v, %v", separate, result, err)
+		}
+		got := result.Updated[0]
+		if len(got.Menus) != 4 || got.Menus[3].Name != "모둠전" || got.Menus[1].Price != 25000 || got.Categories[0] != "한식" {
+			t.Fatalf("다시 가져온 식당이 다름 (메뉴 파일 %v): %+v", separate, got)
+		}
+	}
+}
@@ -0,0 +1,368 @@
+package restaurant
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 맵기 단계. 0은 맵지 않음이다.
+const maxSpicy = 3
+
+// 식품 알레르기 표시 대상 (식품 등의 표시·광고에 관한 법률)
+var Allergens = []string{
+	"알류", "우유", "메밀", "땅콩", "대두", "밀", "고등어", "게", "새우", "돼지고기",
+	"복숭아", "토마토", "아황산류", "호두", "닭고기", "쇠고기", "오징어", "조개류", "잣",
+}
+
+// 채식 메뉴에 들어 있을 수 없는 알레르기 성분
+var meatAllergens = []string{"고등어", "게", "새우", "돼지고기", "닭고기", "쇠고기", "오징어", "조개류"}
+
+// 해산물에 들어 있을 수 있는 알레르기 성분
+var seafoodAllergens = []string{"고등어", "게", "새우", "오징어", "조개류"}
+
+// 메뉴나 식당의 식단 정보
+// 채식, 돼지고기 없음처럼 "없다"는 사실은 기록된 것만 믿고, 해산물, 맵기, 알레르기 성분은 기록된 것만 있다고 본다.
+// 다만 알레르기는 위험하므로 해산물이 있는데 해산물 알레르기 성분을 적지 않았으면 모두 들어 있다고 보고,
+// 식단 정보가 없는 메뉴는 알레르기가 있는 사람에게 추천하지 않는다.
+type Diet struct {
+	// 고기와 해산물이 없음 (알, 우유는 있을 수 있음)
+	Vegetarian bool `json:"vegetarian,omitempty"`
+	PorkFree   bool `json:"pork_free,omitempty"`
+	Seafood    bool `json:"seafood,omitempty"`
+	// 0(맵지 않음)~3
+	Spicy     int      `json:"spicy,omitempty"`
+	Allergens []string `json:"allergens,omitempty"`
+}
+
+func validateAllergens(list []string) ([]string, error) {
+	cleaned := make([]string, 0, len(list))
+	for _, a := range list {
+		a = cleanTerm(a)
+		if !contains(Allergens, a) {
+			return nil, fmt.Errorf("알 수 없는 알레르기 성분입니다: %s (%s)", a, strings.Join(Allergens, ", "))
+		}
+		if contains(cleaned, a) {
+			return nil, fmt.Errorf("알레르기 성분이 중복됩니다: %s", a)
+		}
+		cleaned = append(cleaned, a)
+	}
+	return cleaned, nil
+}
+
+func (d *Diet) Validate() error {
+	if d.Spicy < 0 || d.Spicy > maxSpicy {
+		return fmt.Errorf("spicy는 0~%d 사이여야 합니다: %d", maxSpicy, d.Spicy)
+	}
+	allergens, err := validateAllergens(d.Allergens)
+	if err != nil {
+		return err
+	}
+	d.Allergens = allergens
+	if d.Vegetarian {
+		if d.Seafood {
+			return fmt.Errorf("채식 메뉴에 해산물이 들어 있을 수 없습니다")
+		}
+		for _, a := range d.Allergens {
+			if contains(meatAllergens, a) {
+				return fmt.Errorf("채식 메뉴에 %s이(가) 들어 있을 수 없습니다", a)
+			}
+		}
+	}
+	return nil
+}
+
+// 채식, 돼지고기 없음, 해산물, 맵기=2, 땅콩 같은 형식
+func (d *Diet) String() string {
+	parts := []string{}
+	if d.Vegetarian {
+		parts = append(parts, "채식")
+	}
+	if d.PorkFree && !d.Vegetarian {
+		parts = append(parts, "돼지고기 없음")
+	}
+	if d.Seafood {
+		parts = append(parts, "해산물")
+	}
+	if d.Spicy > 0 {
+		parts = append(parts, fmt.Sprintf("맵기=%d", d.Spicy))
+	}
+	parts = append(parts, d.Allergens...)
+	return strings.Join(parts, ", ")
+}
+
+// 쉼표로 구분한 식단 정보를 해석함. 빈 문자열이면 nil
+// 예: "vegetarian, spicy=2, 땅콩" 또는 "돼지고기 없음, 해산물, 맵기=1, 새우"
+func ParseDiet(s string) (*Diet, error) {
+	d := &Diet{}
+	empty := true
+	for _, part := range strings.Split(s, ",") {
+		part = cleanTerm(part)
+		if part == "" {
+			continue
+		}
+		empty = false
+		key, value, hasValue := strings.Cut(part, "=")
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "vegetarian", "채식":
+			d.Vegetarian = true
+		case "pork-free", "pork_free", "돼지고기 없음", "돼지고기없음":
+			d.PorkFree = true
+		case "seafood", "해산물":
+			d.Seafood = true
+		case "spicy", "맵기":
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if !hasValue || err != nil {
+				return nil, fmt.Errorf("맵기는 맵기=0~%d 형식이어야 합니다: %s", maxSpicy, part)
+			}
+			d.Spicy = n
+		default:
+			d.Allergens = append(d.Allergens, part)
+		}
+	}
+	if empty {
+		return nil, nil
+	}
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// 한 사람이 먹을 수 없는 것. 추천할 때 반드시 지키는 조건이다.
+type DietProfile struct {
+	Name       string `json:"name"`
+	Vegetarian bool   `json:"vegetarian,omitempty"`
+	NoPork     bool   `json:"no_pork,omitempty"`
+	NoSeafood  bool   `json:"no_seafood,omitempty"`
+	// 먹을 수 있는 가장 매운 단계. 없으면 제한이 없다.
+	MaxSpicy  *int     `json:"max_spicy,omitempty"`
+	Allergens []string `json:"allergens,omitempty"`
+}
+
+func (p *DietProfile) Validate() error {
+	p.Name = cleanTerm(p.Name)
+	if p.Name == "" {
+		return fmt.Errorf("프로필 name은 필수입니다")
+	}
+	if p.MaxSpicy != nil && (*p.MaxSpicy < 0 || *p.MaxSpicy > maxSpicy) {
+		return fmt.Errorf("%s max_spicy는 0~%d 사이여야 합니다: %d", p.Name, maxSpicy, *p.MaxSpicy)
+	}
+	allergens, err := validateAllergens(p.Allergens)
+	if err != nil {
+		return fmt.Errorf("%s: %w", p.Name, err)
+	}
+	p.Allergens = allergens
+	return nil
+}
+
+// 채식, 돼지고기 안 먹음, 맵기 1까지, 게 같은 형식
+func (p *DietProfile) Summary() string {
+	parts := []string{}
+	if p.Vegetarian {
+		parts = append(parts, "채식")
+	}
+	if p.NoPork && !p.Vegetarian {
+		parts = append(parts, "돼지고기 안 먹음")
+	}
+	if p.NoSeafood && !p.Vegetarian {
+		parts = append(parts, "해산물 안 먹음")
+	}
+	if p.MaxSpicy != nil {
+		parts = append(parts, fmt.Sprintf("맵기 %d까지", *p.MaxSpicy))
+	}
+	if len(p.Allergens) > 0 {
+		parts = append(parts, "알레르기 "+strings.Join(p.Allergens, ", "))
+	}
+	if len(parts) == 0 {
+		return "제한 없음"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// 같이 먹는 사람들의 조건을 모두 합친 조건. 프로필이 없으면 nil
+func mergeProfiles(profiles []DietProfile) *DietProfile {
+	if len(profiles) == 0 {
+		return nil
+	}
+	merged := &DietProfile{}
+	names := []string{}
+	for _, p := range profiles {
+		names = append(names, p.Name)
+		merged.Vegetarian = merged.Vegetarian || p.Vegetarian
+		merged.NoPork = merged.NoPork || p.NoPork
+		merged.NoSeafood = merged.NoSeafood || p.NoSeafood
+		if p.MaxSpicy != nil && (merged.MaxSpicy == nil || *p.MaxSpicy < *merged.MaxSpicy) {
+			spicy := *p.MaxSpicy
+			merged.MaxSpicy = &spicy
+		}
+		for _, a := range p.Allergens {
+			if !contains(merged.Allergens, a) {
+				merged.Allergens = append(merged.Allergens, a)
+			}
+		}
+	}
+	merged.Name = strings.Join(names, ", ")
+	return merged
+}
+
+// 식단 정보가 d인 메뉴를 먹을 수 있는지 확인함. d가 nil이면 아무것도 기록되지 않은 메뉴다.
+func (p *DietProfile) allows(d *Diet) bool {
+	if p == nil {
+		return true
+	}
+	if d == nil {
+		// 알레르기 성분을 알 수 없으므로 알레르기가 있으면 먹을 수 없다고 봄
+		if len(p.Allergens) > 0 {
+			return false
+		}
+		d = &Diet{}
+	}
+	if p.Vegetarian && !d.Vegetarian {
+		return false
+	}
+	if p.NoPork && !d.PorkFree && !d.Vegetarian {
+		return false
+	}
+	if p.NoSeafood && d.Seafood {
+		return false
+	}
+	if p.MaxSpicy != nil && d.Spicy > *p.MaxSpicy {
+		return false
+	}
+	if d.Seafood && !hasAny(d.Allergens, seafoodAllergens) && hasAny(p.Allergens, seafoodAllergens) {
+		return false
+	}
+	for _, a := range d.Allergens {
+		if contains(p.Allergens, a) {
+			return false
+		}
+	}
+	return true
+}
+
+// 메뉴의 식단 정보. 메뉴에 없으면 식당의 식단 정보를 쓴다.
+func (r *Restaurant) menuDiet(m *Menu) *Diet {
+	if m.Diet != nil {
+		return m.Diet
+	}
+	return r.Diet
+}
+
+// 먹을 수 있는 메뉴가 하나라도 있는 식당인지 확인함
+// 메뉴가 없는 식당은 식당의 식단 정보로 판단한다.
+func (p *DietProfile) allowsRestaurant(r *Restaurant) bool {
+	if p == nil {
+		return true
+	}
+	if len(r.Menus) == 0 {
+		return p.allows(r.Diet)
+	}
+	for i := range r.Menus {
+		if p.allows(r.menuDiet(&r.Menus[i])) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *RestaurantData) findProfile(name string) (int, error) {
+	key := termKey(name)
+	for i := range d.Profiles {
+		if termKey(d.Profiles[i].Name) == key {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+}
+
+// 이름으로 고른 프로필을 합친 조건
+// names가 비어 있으면 cli_config.default_profiles를 쓰고, 그것도 없으면 nil이다.
+func (d *RestaurantData) dietFor(names []string) (*DietProfile, error) {
+	if len(names) == 0 {
+		names = d.CLIConfig.DefaultProfiles
+	}
+	profiles := make([]DietProfile, 0, len(names))
+	for _, name := range names {
+		i, err := d.findProfile(name)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, d.Profiles[i])
+	}
+	return mergeProfiles(profiles), nil
+}
+
+// 팀원 모두의 조건을 합친 조건. 팀이 같이 먹는 오늘의 추천과 주간 계획에 쓴다.
+func (d *RestaurantData) teamDiet() *DietProfile {
+	return mergeProfiles(d.Profiles)
+}
+
+// 이름 순서로 정렬한 식단 프로필 목록
+func (s *Service) Profiles() ([]DietProfile, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	profiles := append([]DietProfile{}, data.Profiles...)
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Name < profiles[j].Name })
+	return profiles, nil
+}
+
+// 식단 프로필을 추가하거나 같은 이름의 프로필을 바꿈
+func (s *Service) SetProfile(p DietProfile) (*DietProfile, error) {
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	if i, err := data.findProfile(p.Name); err == nil {
+		data.Profiles[i] = p
+	} else {
+		data.Profiles = append(data.Profiles, p)
+	}
+	if err := s.repo.Save(data); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (s *Service) DeleteProfile(name string) error {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return err
+	}
+	i, err := data.findProfile(name)
+	if err != nil {
+		return err
+	}
+	data.Profiles = append(data.Profiles[:i], data.Profiles[i+1:]...)
+	for j := 0; j < len(data.CLIConfig.DefaultProfiles); j++ {
+		if termKey(data.CLIConfig.DefaultProfiles[j]) == termKey(name) {
+			data.CLIConfig.DefaultProfiles = append(data.CLIConfig.DefaultProfiles[:j], data.CLIConfig.DefaultProfiles[j+1:]...)
+			j--
+		}
+	}
+	return s.repo.Save(data)
+}
+
+// 추천할 때 따로 고르지 않으면 적용할 식단 프로필을 정함. 비어 있으면 아무 프로필도 적용하지 않는다.
+func (s *Service) SetDefaultProfiles(names []string) error {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return err
+	}
+	defaults := make([]string, 0, len(names))
+	for _, name := range names {
+		i, err := data.findProfile(name)
+		if err != nil {
+			return err
+		}
+		defaults = append(defaults, data.Profiles[i].Name)
+	}
+	data.CLIConfig.DefaultProfiles = defaults
+	return s.repo.Save(data)
+}
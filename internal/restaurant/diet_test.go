@@ -0,0 +1,175 @@
+package restaurant
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseDiet(t *testing.T) {
+	d, err := ParseDiet("vegetarian, 맵기=2, 땅콩,  대두 ")
+	if err != nil {
+		t.Fatalf("해석 실패: %v", err)
+	}
+	if !d.Vegetarian || d.Spicy != 2 || len(d.Allergens) != 2 || d.Allergens[1] != "대두" {
+		t.Fatalf("식단 정보가 다름: %+v", d)
+	}
+	if got := d.String(); got != "채식, 맵기=2, 땅콩, 대두" {
+		t.Fatalf("문자열 %q", got)
+	}
+	if d, err := ParseDiet(" "); d != nil || err != nil {
+		t.Fatalf("빈 문자열에 %+v, %v", d, err)
+	}
+
+	for _, s := range []string{"조개", "spicy=4", "맵기", "채식, 해산물", "vegetarian, 새우", "땅콩, 땅콩"} {
+		if _, err := ParseDiet(s); err == nil {
+			t.Errorf("%q: 오류가 없음", s)
+		}
+	}
+}
+
+func dietTestData() *RestaurantData {
+	list := testRestaurants("샐러드집", "해물집", "국밥집", "분식집")
+	list[0].Menus = []Menu{{Name: "그린 샐러드", Diet: &Diet{Vegetarian: true}}, {Name: "닭가슴살 샐러드", Diet: &Diet{Allergens: []string{"닭고기"}}}}
+	// 메뉴마다 적지 않고 식당 전체에 적은 경우
+	list[1].Menus = []Menu{{Name: "해물탕"}, {Name: "새우튀김"}}
+	list[1].Diet = &Diet{Seafood: true, Spicy: 2, Allergens: []string{"새우", "조개류"}}
+	list[2].Menus = []Menu{{Name: "순대국", Diet: &Diet{Spicy: 1, Allergens: []string{"돼지고기"}}}, {Name: "소고기국밥", Diet: &Diet{PorkFree: true, Allergens: []string{"쇠고기"}}}}
+	maxSpicy := 1
+	return &RestaurantData{
+		Restaurants: list,
+		Profiles: []DietProfile{
+			{Name: "영희", Vegetarian: true},
+			{Name: "민수", Allergens: []string{"새우", "게", "조개류"}},
+			{Name: "지수", NoPork: true, MaxSpicy: &maxSpicy},
+		},
+	}
+}
+
+func TestDietProfile_AllowsRestaurant(t *testing.T) {
+	data := dietTestData()
+	cases := []struct {
+		profiles []string
+		want     []string
+	}{
+		// 메뉴 정보가 없는 분식집은 채식인지 알 수 없으므로 뺌
+		{[]string{"영희"}, []string{"샐러드집"}},
+		// 알레르기 성분을 알 수 없는 분식집은 알레르기가 있는 민수에게서 뺌
+		{[]string{"민수"}, []string{"샐러드집", "국밥집"}},
+		// 돼지고기가 없는 소고기국밥이 있으므로 국밥집은 남음
+		{[]string{"지수"}, []string{"샐러드집", "국밥집"}},
+		{[]string{"민수", "지수"}, []string{"샐러드집", "국밥집"}},
+		{nil, []string{"샐러드집", "해물집", "국밥집", "분식집"}},
+	}
+	for _, tc := range cases {
+		diet, err := data.dietFor(tc.profiles)
+		if err != nil {
+			t.Fatalf("%q: %v", tc.profiles, err)
+		}
+		got := []string{}
+		for i := range data.Restaurants {
+			if diet.allowsRestaurant(&data.Restaurants[i]) {
+				got = append(got, data.Restaurants[i].Name)
+			}
+		}
+		if !sameNames(got, tc.want) {
+			t.Errorf("%q: %q, 기대값 %q", tc.profiles, got, tc.want)
+		}
+	}
+
+	if _, err := data.dietFor([]string{"없는 사람"}); !errors.Is(err, ErrProfileNotFound) {
+		t.Fatalf("없는 프로필에 ErrProfileNotFound가 아님: %v", err)
+	}
+}
+
+func TestDietProfile_AllowsSeafoodAllergy(t *testing.T) {
+	profile := &DietProfile{Name: "민수", Allergens: []string{"새우"}}
+	cases := []struct {
+		diet *Diet
+		want bool
+	}{
+		// 해산물이 있는데 성분을 적지 않았으면 새우가 들어 있을 수 있음
+		{&Diet{Seafood: true}, false},
+		{&Diet{Seafood: true, Allergens: []string{"오징어"}}, true},
+		{&Diet{Seafood: true, Allergens: []string{"새우"}}, false},
+		{&Diet{Spicy: 1}, true},
+		{nil, false},
+	}
+	for _, tc := range cases {
+		if got := profile.allows(tc.diet); got != tc.want {
+			t.Errorf("%+v: %v, 기대값 %v", tc.diet, got, tc.want)
+		}
+	}
+	if !(&DietProfile{Name: "지수"}).allows(nil) {
+		t.Fatal("알레르기가 없는 프로필이 식단 정보가 없는 메뉴를 뺌")
+	}
+}
+
+func TestServiceRecommend_Profiles(t *testing.T) {
+	s := newTestService(t, dietTestData(), time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local))
+	picks, err := s.Recommend(RecommendOptions{Count: 4, Profiles: []string{"지수"}})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	for _, p := range picks {
+		if p.Name != "샐러드집" && p.Name != "국밥집" {
+			t.Fatalf("프로필에 맞지 않는 식당이 추천됨: %q", namesOf(picks))
+		}
+		if p.Name == "국밥집" && (p.Order == nil || p.Order.Name != "소고기국밥") {
+			t.Fatalf("먹을 수 없는 메뉴가 추천됨: %+v", p.Order)
+		}
+	}
+
+	// 오늘의 추천은 팀원 모두의 프로필을 지킴
+	picks, err = s.Recommend(RecommendOptions{Count: 4, Daily: true})
+	if err != nil {
+		t.Fatalf("오늘의 추천 실패: %v", err)
+	}
+	if len(picks) != 1 || picks[0].Name != "샐러드집" || picks[0].Order.Name != "그린 샐러드" {
+		t.Fatalf("팀원 모두가 먹을 수 있는 식당이 아님: %q", namesOf(picks))
+	}
+}
+
+func TestServiceDefaultProfiles(t *testing.T) {
+	s := newTestService(t, dietTestData(), time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local))
+	if err := s.SetDefaultProfiles([]string{"영희"}); err != nil {
+		t.Fatalf("기본 프로필 저장 실패: %v", err)
+	}
+	picks, err := s.Recommend(RecommendOptions{Count: 4})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	if len(picks) != 1 || picks[0].Name != "샐러드집" {
+		t.Fatalf("기본 프로필이 적용되지 않음: %q", namesOf(picks))
+	}
+
+	if err := s.DeleteProfile("영희"); err != nil {
+		t.Fatalf("프로필 삭제 실패: %v", err)
+	}
+	if picks, err = s.Recommend(RecommendOptions{Count: 4}); err != nil || len(picks) != 4 {
+		t.Fatalf("삭제한 프로필이 기본 프로필에 남음: %q, %v", namesOf(picks), err)
+	}
+	if err := s.SetDefaultProfiles([]string{"영희"}); !errors.Is(err, ErrProfileNotFound) {
+		t.Fatalf("없는 프로필에 ErrProfileNotFound가 아님: %v", err)
+	}
+}
+
+func TestRestaurantDataValidate_Profiles(t *testing.T) {
+	data := dietTestData()
+	data.Profiles = append(data.Profiles, DietProfile{Name: " 영희"})
+	if err := data.Validate(); err == nil {
+		t.Fatal("중복된 프로필 이름에 오류가 없음")
+	}
+
+	data = dietTestData()
+	data.Profiles[1].Allergens = []string{"갑각류"}
+	if err := data.Validate(); err == nil {
+		t.Fatal("알 수 없는 알레르기 성분에 오류가 없음")
+	}
+
+	data = dietTestData()
+	data.Restaurants[1].Menus[0].Diet = &Diet{Vegetarian: true, Allergens: []string{"오징어"}}
+	if err := data.Validate(); err == nil {
+		t.Fatal("오징어가 든 채식 메뉴에 오류가 없음")
+	}
+}
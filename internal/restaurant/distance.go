@@ -0,0 +1,191 @@
+package restaurant
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arch-spatula/jmc/internal/geo"
+)
+
+// 거리를 재는 기준 위치 (예: 회사, 집)
+// JSON으로는 {"name": "회사", "lat": 37.5, "lng": 127.0} 형태다.
+type Origin struct {
+	Name string `json:"name"`
+	geo.Point
+}
+
+func (o *Origin) Validate() error {
+	if o.Name == "" {
+		return fmt.Errorf("origin name은 필수입니다")
+	}
+	if err := o.Point.Validate(); err != nil {
+		return fmt.Errorf("origin %s: %w", o.Name, err)
+	}
+	return nil
+}
+
+// 기준 위치에서 식당까지의 거리
+type Walk struct {
+	From    string  `json:"from"`
+	Meters  float64 `json:"meters"`
+	Minutes int     `json:"minutes"`
+}
+
+func (w Walk) Duration() time.Duration {
+	return time.Duration(w.Minutes) * time.Minute
+}
+
+// "350m, 도보 6분" 형태
+func (w Walk) String() string {
+	distance := fmt.Sprintf("%.0fm", w.Meters)
+	if w.Meters >= 1000 {
+		distance = fmt.Sprintf("%.1fkm", w.Meters/1000)
+	}
+	return fmt.Sprintf("%s, 도보 %d분", distance, w.Minutes)
+}
+
+// 기준 위치를 이름으로 찾음
+// 이름이 비어 있으면 첫 번째 기준 위치를 쓰고, 기준 위치가 하나도 없으면 nil을 반환한다.
+func (c *CLIConfig) FindOrigin(name string) (*Origin, error) {
+	if name == "" {
+		if len(c.Origins) == 0 {
+			return nil, nil
+		}
+		return &c.Origins[0], nil
+	}
+	names := make([]string, 0, len(c.Origins))
+	for i := range c.Origins {
+		if c.Origins[i].Name == name {
+			return &c.Origins[i], nil
+		}
+		names = append(names, c.Origins[i].Name)
+	}
+	return nil, fmt.Errorf("%w: 알 수 없는 기준 위치입니다: %s (가능한 위치: %s)", ErrInvalid, name, strings.Join(names, ", "))
+}
+
+// 기준 위치에서 식당까지 걸어가는 거리. 식당 좌표가 없으면 false
+func (c *CLIConfig) WalkFrom(origin *Origin, r *Restaurant) (Walk, bool) {
+	if origin == nil || r.Coord == nil {
+		return Walk{}, false
+	}
+	meters := geo.Distance(origin.Point, *r.Coord)
+	return Walk{From: origin.Name, Meters: meters, Minutes: int(geo.WalkTime(meters, c.WalkSpeed).Minutes())}, true
+}
+
+// 최대 도보 시간을 해석함. "10m", "1h" 같은 기간이나 분 단위 숫자를 받는다.
+func ParseMaxWalk(s string) (time.Duration, error) {
+	if minutes, err := strconv.Atoi(s); err == nil && minutes > 0 {
+		return time.Duration(minutes) * time.Minute, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%w: 최대 도보 시간은 10m 같은 형식이어야 합니다: %s", ErrInvalid, s)
+	}
+	return d, nil
+}
+
+// 추천 후보를 거르는 조건
+type conditions struct {
+	exclude []string
+	// 거리를 재는 기준 위치. nil이면 거리를 따지지 않는다.
+	origin *Origin
+	// 0보다 크면 origin에서 이 시간 안에 걸어갈 수 있는 식당만 남긴다.
+	// 좌표가 없는 식당은 거리를 알 수 없으므로 제외한다.
+	maxWalk time.Duration
+	// 비어 있지 않으면 이 지역(하위 지역 포함)에 있는 식당만 남긴다.
+	region string
+	// nil이 아니면 검색식에 맞는 식당만 남긴다.
+	query *Query
+	// 0보다 크면 가장 싼 한 끼가 이 가격 안에 드는 식당만 남긴다.
+	budget int
+	// nil이 아니면 식대 페이스를 넘은 것이므로 싼 식당에 가산점을 준다.
+	pace *spendingPace
+	// nil이 아니면 이 조건으로 먹을 수 있는 메뉴가 있는 식당만 남긴다.
+	diet *DietProfile
+}
+
+// 조건에 맞으면 식당까지의 거리(모르면 nil)를 반환함
+func (cond *conditions) walk(cfg *CLIConfig, r *Restaurant) (*Walk, bool) {
+	walk, ok := cfg.WalkFrom(cond.origin, r)
+	if cond.maxWalk > 0 && (!ok || walk.Duration() > cond.maxWalk) {
+		return nil, false
+	}
+	if !ok {
+		return nil, true
+	}
+	return &walk, true
+}
+
+// 거리 조건을 만듦. 최대 도보 시간이 있는데 기준 위치가 없으면 에러
+func (d *RestaurantData) walkConditions(from string, maxWalk time.Duration) (conditions, error) {
+	origin, err := d.CLIConfig.FindOrigin(from)
+	if err != nil {
+		return conditions{}, err
+	}
+	if maxWalk > 0 && origin == nil {
+		return conditions{}, fmt.Errorf("%w: 최대 도보 시간을 쓰려면 cli_config.origins에 기준 위치가 있어야 합니다", ErrInvalid)
+	}
+	return conditions{origin: origin, maxWalk: maxWalk}, nil
+}
+
+type ListOptions struct {
+	// 기준 위치 이름. 비어 있으면 첫 번째 기준 위치
+	From    string
+	MaxWalk time.Duration
+	// 지역 이름이나 별칭. 하위 지역의 식당도 포함한다.
+	Region string
+	// 검색식. 비어 있지 않으면 검색식에 맞는 식당만 보여준다.
+	Query string
+}
+
+// 목록에 보여줄 식당
+type Listing struct {
+	Restaurant
+	Walk *Walk `json:"walk,omitempty"`
+	// 메뉴 가격 범위. 가격이 있는 메뉴가 없으면 nil
+	Prices *PriceRange `json:"price_range,omitempty"`
+}
+
+// 식당 목록을 기준 위치에서 가까운 순서로 반환함
+// 좌표가 없는 식당은 뒤에 원래 순서대로 온다.
+func (s *Service) List(opts ListOptions) ([]Listing, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	cond, err := data.walkConditions(opts.From, opts.MaxWalk)
+	if err != nil {
+		return nil, err
+	}
+	query, err := ParseQuery(opts.Query)
+	if err != nil {
+		return nil, err
+	}
+	ctx := data.queryContext(s.now())
+
+	list := make([]Listing, 0, len(data.Restaurants))
+	for _, rest := range data.Restaurants {
+		if opts.Region != "" && !ctx.regions.matchesAny(rest.Locations, []string{opts.Region}) {
+			continue
+		}
+		if !query.Matches(ctx, &rest) {
+			continue
+		}
+		walk, ok := cond.walk(&data.CLIConfig, &rest)
+		if !ok {
+			continue
+		}
+		list = append(list, Listing{Restaurant: rest, Walk: walk, Prices: rest.priceRange()})
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i].Walk, list[j].Walk
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Meters < b.Meters
+	})
+	return list, nil
+}
@@ -0,0 +1,119 @@
+package restaurant
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/arch-spatula/jmc/internal/geo"
+)
+
+// 회사에서 약 100m, 500m, 1.5km 떨어진 식당과 좌표가 없는 식당
+func walkTestData() *RestaurantData {
+	list := testRestaurants("먼곳", "가까운곳", "좌표없음", "중간")
+	list[0].Coord = &geo.Point{Lat: 37.5135, Lng: 127.0}
+	list[1].Coord = &geo.Point{Lat: 37.5009, Lng: 127.0}
+	list[3].Coord = &geo.Point{Lat: 37.5045, Lng: 127.0}
+	return &RestaurantData{
+		Restaurants: list,
+		CLIConfig: CLIConfig{Origins: []Origin{
+			{Name: "회사", Point: geo.Point{Lat: 37.5, Lng: 127.0}},
+			{Name: "집", Point: geo.Point{Lat: 37.5135, Lng: 127.0}},
+		}},
+	}
+}
+
+func namesOf(candidates []Candidate) []string {
+	names := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+func TestServiceList_SortsByDistance(t *testing.T) {
+	s := newTestService(t, walkTestData(), time.Date(2026, 3, 3, 12, 0, 0, 0, time.Local))
+
+	list, err := s.List(ListOptions{})
+	if err != nil {
+		t.Fatalf("목록 조회 실패: %v", err)
+	}
+	if got, want := listingNames(list), []string{"가까운곳", "중간", "먼곳", "좌표없음"}; !sameNames(got, want) {
+		t.Fatalf("거리 순서가 아님: %q, 기대값 %q", got, want)
+	}
+	if list[3].Walk != nil {
+		t.Fatalf("좌표가 없는 식당에 거리가 있음: %+v", list[3].Walk)
+	}
+
+	list, err = s.List(ListOptions{From: "집"})
+	if err != nil {
+		t.Fatalf("목록 조회 실패: %v", err)
+	}
+	if list[0].Name != "먼곳" || list[0].Walk.From != "집" {
+		t.Fatalf("집에서는 먼곳이 가장 가까워야 함: %+v", list[0])
+	}
+}
+
+func TestServiceRecommend_MaxWalk(t *testing.T) {
+	s := newTestService(t, walkTestData(), time.Date(2026, 3, 3, 12, 0, 0, 0, time.Local))
+
+	picks, err := s.Recommend(RecommendOptions{Count: 4, MaxWalk: 10 * time.Minute})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	if len(picks) != 2 {
+		t.Fatalf("10분 안의 식당 2개를 기대했지만 %v", namesOf(picks))
+	}
+	for _, p := range picks {
+		if p.Walk == nil || p.Walk.Duration() > 10*time.Minute {
+			t.Fatalf("도보 10분을 넘는 식당이 추천됨: %s %+v", p.Name, p.Walk)
+		}
+	}
+
+	if _, err := s.Recommend(RecommendOptions{From: "학교"}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("없는 기준 위치에 ErrInvalid를 기대했지만 %v", err)
+	}
+}
+
+func TestServiceRecommend_MaxWalkNeedsOrigin(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("a")}, time.Time{})
+	if _, err := s.Recommend(RecommendOptions{MaxWalk: 10 * time.Minute}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("기준 위치가 없으면 ErrInvalid를 기대했지만 %v", err)
+	}
+}
+
+func TestScoreCandidates_ArrivalIncludesWalk(t *testing.T) {
+	s := newTestService(t, walkTestData(), time.Date(2026, 3, 3, 12, 0, 0, 0, time.Local))
+	data, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("데이터 읽기 실패: %v", err)
+	}
+	// 먼곳은 12시 10분에 문을 닫아 걸어가면 이미 닫혀 있음
+	data.Restaurants[0].Hours = &OpeningHours{Days: map[string]DayHours{"평일": {Open: "11:00", Close: "12:10"}}}
+	origin, err := data.CLIConfig.FindOrigin("")
+	if err != nil {
+		t.Fatalf("기준 위치 찾기 실패: %v", err)
+	}
+
+	now := time.Date(2026, 3, 3, 12, 0, 0, 0, time.Local)
+	candidates := scoreCandidates(data, &DefaultModes()[0], now, conditions{origin: origin})
+	if contains(namesOf(candidates), "먼곳") {
+		t.Fatal("도착하면 닫혀 있는 식당이 후보에 있음")
+	}
+	candidates = scoreCandidates(data, &DefaultModes()[0], now, conditions{})
+	if !contains(namesOf(candidates), "먼곳") {
+		t.Fatal("기준 위치가 없으면 지금 시각으로 영업 여부를 봐야 함")
+	}
+}
+
+func TestParseMaxWalk(t *testing.T) {
+	for input, want := range map[string]time.Duration{"10m": 10 * time.Minute, "15": 15 * time.Minute, "1h": time.Hour} {
+		got, err := ParseMaxWalk(input)
+		if err != nil || got != want {
+			t.Fatalf("%s: %v를 기대했지만 %v (%v)", input, want, got, err)
+		}
+	}
+	if _, err := ParseMaxWalk("열분"); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("잘못된 형식에 ErrInvalid를 기대했지만 %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+package restaurant
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 카테고리 다양성 설정
+// 최근 Lookback번의 방문과 카테고리가 겹치면 감점하고, Rules에 맞지 않는 식당은 추천하지 않는다.
+type Diversity struct {
+	Lookback int `json:"lookback"`
+	// 가장 최근 방문과 겹칠 때의 감점. 오래된 방문일수록 작아진다.
+	Penalty float64        `json:"penalty"`
+	Rules   []CategoryRule `json:"rules"`
+}
+
+// 같은 카테고리를 Days일 안에 다시 먹지 않는 규칙
+// Category가 비어 있으면 모든 카테고리에 적용한다.
+type CategoryRule struct {
+	Category string `json:"category"`
+	Days     int    `json:"days"`
+}
+
+func (dv *Diversity) Validate() error {
+	if dv.Lookback < 0 {
+		return fmt.Errorf("diversity lookback은 0 이상이어야 합니다")
+	}
+	for _, rule := range dv.Rules {
+		if rule.Days <= 0 {
+			return fmt.Errorf("diversity 규칙의 days는 1 이상이어야 합니다: %s", rule.Category)
+		}
+	}
+	return nil
+}
+
+// 방문한 식당의 카테고리를 함께 담은 방문 기록
+type categorizedVisit struct {
+	Visit
+	date       time.Time
+	categories []string
+}
+
+// 최근 방문 순서로 정렬한 방문 기록
+// 같은 날짜면 나중에 기록된 방문이 앞에 온다.
+func (d *RestaurantData) recentVisits() []categorizedVisit {
+	categories := make(map[string][]string, len(d.Restaurants))
+	for _, rest := range d.Restaurants {
+		categories[rest.Name] = rest.Categories
+	}
+
+	visits := make([]categorizedVisit, 0, len(d.Visits))
+	for i := len(d.Visits) - 1; i >= 0; i-- {
+		v := d.Visits[i]
+		date, err := time.ParseInLocation(dateLayout, v.Date, time.Local)
+		if err != nil {
+			continue
+		}
+		visits = append(visits, categorizedVisit{Visit: v, date: date, categories: categories[v.Restaurant]})
+	}
+	sort.SliceStable(visits, func(i, j int) bool {
+		return visits[i].date.After(visits[j].date)
+	})
+	return visits
+}
+
+// 규칙에 걸리면 이유를 반환함
+func (dv *Diversity) blocked(rest *Restaurant, recent []categorizedVisit, now time.Time) (string, bool) {
+	for _, rule := range dv.Rules {
+		for _, v := range recent {
+			days := daysBetween(v.date, now)
+			if days >= rule.Days {
+				break
+			}
+			shared := sharedCategories(rest.Categories, v.categories)
+			if rule.Category != "" {
+				if !contains(shared, rule.Category) {
+					continue
+				}
+				shared = []string{rule.Category}
+			}
+			if len(shared) > 0 {
+				return fmt.Sprintf("%d일 전 %s (%s), %d일 안에 같은 카테고리 금지", days, v.Restaurant, strings.Join(shared, ", "), rule.Days), true
+			}
+		}
+	}
+	return "", false
+}
+
+// 최근 방문과 카테고리가 겹치는 만큼 감점
+func (dv *Diversity) penalty(rest *Restaurant, recent []categorizedVisit) (float64, string) {
+	if dv.Lookback <= 0 || dv.Penalty == 0 {
+		return 0, ""
+	}
+	total := 0.0
+	repeats := 0
+	seen := []string{}
+	for i, v := range recent {
+		if i >= dv.Lookback {
+			break
+		}
+		shared := sharedCategories(rest.Categories, v.categories)
+		if len(shared) == 0 {
+			continue
+		}
+		total += dv.Penalty * float64(dv.Lookback-i) / float64(dv.Lookback)
+		repeats++
+		for _, c := range shared {
+			if !contains(seen, c) {
+				seen = append(seen, c)
+			}
+		}
+	}
+	if repeats == 0 {
+		return 0, ""
+	}
+	return -total, fmt.Sprintf("최근 %d번 방문 중 %d번 같은 카테고리 (%s)", dv.Lookback, repeats, strings.Join(seen, ", "))
+}
+
+func sharedCategories(a, b []string) []string {
+	shared := []string{}
+	for _, c := range a {
+		if contains(b, c) && !contains(shared, c) {
+			shared = append(shared, c)
+		}
+	}
+	return shared
+}
@@ -0,0 +1,107 @@
+package restaurant
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func diversityData() *RestaurantData {
+	list := testRestaurants("국밥A", "국밥B", "파스타")
+	list[0].Categories = []string{"한식", "국밥"}
+	list[1].Categories = []string{"한식", "국밥"}
+	list[2].Categories = []string{"양식"}
+	return &RestaurantData{
+		Restaurants: list,
+		Visits: []Visit{
+			{Restaurant: "국밥A", Date: "2026-03-01"},
+			{Restaurant: "파스타", Date: "2026-02-20"},
+		},
+	}
+}
+
+func TestDiversity_PenalizesRecentCategory(t *testing.T) {
+	data := diversityData()
+	mode := &Mode{Name: "테스트", Diversity: Diversity{Lookback: 2, Penalty: 2}}
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local)
+
+	candidates := scoreCandidates(data, mode, now, conditions{})
+	// 국밥B는 가장 최근 방문(국밥A)과 겹침 → -2
+	if v, _ := factorValue(candidates[1], "diversity"); v != -2 {
+		t.Fatalf("diversity 요인 -2를 기대했지만 %v", v)
+	}
+	// 파스타는 두 번째로 최근 방문과 겹침 → -1
+	if v, _ := factorValue(candidates[2], "diversity"); v != -1 {
+		t.Fatalf("diversity 요인 -1을 기대했지만 %v", v)
+	}
+}
+
+func TestDiversity_RuleBlocksSameCategory(t *testing.T) {
+	data := diversityData()
+	mode := &Mode{Name: "테스트", Diversity: Diversity{Rules: []CategoryRule{{Category: "국밥", Days: 3}}}}
+
+	candidates := scoreCandidates(data, mode, time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local), conditions{})
+	// 제외된 후보도 이유와 함께 남고 뽑힐 확률은 0
+	for _, c := range candidates[:2] {
+		if c.Explain.Excluded == "" || c.weight() != 0 || c.Explain.Probability != 0 {
+			t.Fatalf("3일 안에 국밥은 제외되어야 하지만 %+v", c.Explain)
+		}
+		if len(c.Explain.Factors) != 1 || c.Explain.Factors[0] != (Factor{Name: "excluded", Detail: c.Explain.Excluded}) {
+			t.Fatalf("제외 이유가 요인에 없음: %+v", c.Explain.Factors)
+		}
+	}
+	if candidates[2].Explain.Excluded != "" || candidates[2].Explain.Probability != 1 {
+		t.Fatalf("파스타만 뽑혀야 하지만 %+v", candidates[2].Explain)
+	}
+	picks := pickWeighted(rand.New(rand.NewSource(1)), candidates, 3)
+	if len(picks) != 1 || picks[0].Name != "파스타" {
+		t.Fatalf("제외된 후보가 뽑힘: %q", namesOf(picks))
+	}
+
+	// 규칙 기간이 지나면 다시 추천 대상이 됨
+	candidates = scoreCandidates(data, mode, time.Date(2026, 3, 4, 12, 0, 0, 0, time.Local), conditions{})
+	for _, c := range candidates {
+		if c.Explain.Excluded != "" {
+			t.Fatalf("규칙 기간이 지났는데 제외됨: %s", c.Explain.Excluded)
+		}
+	}
+}
+
+func TestSelectCandidates_RandomKeepsRules(t *testing.T) {
+	data := diversityData()
+	mode := &Mode{Name: "랜덤", Strategy: StrategyRandom, Diversity: Diversity{Lookback: 2, Penalty: 5, Rules: []CategoryRule{{Category: "국밥", Days: 3}}}}
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local)
+	for seed := int64(0); seed < 20; seed++ {
+		picks := selectCandidates(data, mode, now, conditions{}, rand.New(rand.NewSource(seed)), 3)
+		if !sameNames(namesOf(picks), []string{"파스타"}) {
+			t.Fatalf("랜덤 모드에서도 규칙에 걸린 국밥은 빠져야 하지만 %q", namesOf(picks))
+		}
+		if _, ok := factorValue(picks[0], "diversity"); ok {
+			t.Fatalf("랜덤 모드에 감점이 적용됨: %+v", picks[0].Explain.Factors)
+		}
+	}
+}
+
+func TestDiversityValidate_InvalidRule(t *testing.T) {
+	dv := Diversity{Rules: []CategoryRule{{Category: "국밥", Days: 0}}}
+	if err := dv.Validate(); err == nil {
+		t.Fatal("days가 0인데 에러가 발생하지 않음")
+	}
+}
+
+func TestServiceRecommendExplained_Excluded(t *testing.T) {
+	data := diversityData()
+	data.CLIConfig.Modes = []Mode{{Name: "테스트", Diversity: Diversity{Rules: []CategoryRule{{Category: "국밥", Days: 3}}}}}
+	s := newTestService(t, data, time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+
+	picks, excluded, err := s.RecommendExplained(RecommendOptions{Count: 3})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	if !sameNames(namesOf(picks), []string{"파스타"}) {
+		t.Fatalf("파스타만 추천되어야 하지만 %q", namesOf(picks))
+	}
+	if len(excluded) != 2 || excluded[0].Name != "국밥A" || excluded[1].Explain.Excluded == "" {
+		t.Fatalf("규칙으로 뺀 식당이 이유와 함께 나와야 하지만 %q", namesOf(excluded))
+	}
+}
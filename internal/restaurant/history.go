@@ -0,0 +1,134 @@
+package restaurant
+
+import (
+	"fmt"
+	"sort"
+)
+
+// 메뉴 가격이 바뀐 기록
+type PriceChange struct {
+	Date string `json:"date"`
+	From int    `json:"from"`
+	To   int    `json:"to"`
+}
+
+// 오른 비율. 0.1이면 10% 올랐다.
+func (c PriceChange) Rate() float64 {
+	if c.From == 0 {
+		return 0
+	}
+	return float64(c.To-c.From) / float64(c.From)
+}
+
+// prev에서 m으로 바뀐 가격을 date 날짜로 기록함
+// 요청에 가격 기록이 없으면(위키처럼 가격 기록을 모르는 곳에서 보낸 메뉴) prev의 기록을 이어 받는다.
+// 같은 날 여러 번 바꾸면 한 번 바뀐 것으로 합친다.
+// 0원은 가격을 모른다는 뜻이므로 0원으로 바뀐 것도, 0원에서 처음 가격을 정한 것도 가격 변화로 기록하지 않는다.
+func trackMenuPrice(m *Menu, prev *Menu, date string) {
+	if m.History == nil {
+		m.History = append([]PriceChange{}, prev.History...)
+	}
+	if m.Price == prev.Price || m.Price == 0 || prev.Price == 0 {
+		return
+	}
+	if n := len(m.History); n > 0 && m.History[n-1].Date == date {
+		m.History[n-1].To = m.Price
+		if m.History[n-1].From == m.Price {
+			m.History = m.History[:n-1]
+		}
+		return
+	}
+	m.History = append(m.History, PriceChange{Date: date, From: prev.Price, To: m.Price})
+}
+
+// 식당을 고쳐 저장하기 전에 prev와 이름이 같은 메뉴의 가격 변화를 기록함
+func trackPrices(item *Restaurant, prev *Restaurant, date string) {
+	for i := range item.Menus {
+		if j := prev.menuIndex(item.Menus[i].Name); j >= 0 {
+			trackMenuPrice(&item.Menus[i], &prev.Menus[j], date)
+		}
+	}
+}
+
+// 메뉴 하나의 가격 기록
+type PriceHistory struct {
+	Restaurant string        `json:"restaurant"`
+	Menu       string        `json:"menu"`
+	Price      int           `json:"price"`
+	History    []PriceChange `json:"history"`
+}
+
+func (s *Service) MenuPrices(name, menuName string) (*PriceHistory, error) {
+	rest, err := s.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	i, err := rest.findMenu(menuName)
+	if err != nil {
+		return nil, err
+	}
+	m := rest.Menus[i]
+	history := m.History
+	if history == nil {
+		history = []PriceChange{}
+	}
+	return &PriceHistory{Restaurant: rest.Name, Menu: m.Name, Price: m.Price, History: history}, nil
+}
+
+// 최근에 가격이 오른 메뉴
+type PriceIncrease struct {
+	Restaurant string `json:"restaurant"`
+	Menu       string `json:"menu"`
+	// 기간 안의 첫 가격에서 마지막 가격까지. Date는 마지막으로 바뀐 날이다.
+	PriceChange
+	Rate float64 `json:"rate"`
+}
+
+// 최근 days일 동안 가격이 많이 오른 메뉴를 오른 비율이 큰 순서로 limit개까지 반환함
+// 기간 안에 여러 번 바뀐 메뉴는 처음 가격과 마지막 가격으로 비교한다. limit이 0 이하면 모두 반환한다.
+func (s *Service) PriceIncreases(days, limit int) ([]PriceIncrease, error) {
+	if days <= 0 {
+		return nil, fmt.Errorf("%w: 기간은 1일 이상이어야 합니다: %d", ErrInvalid, days)
+	}
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	since := s.now().AddDate(0, 0, -days).Format(dateLayout)
+
+	increases := []PriceIncrease{}
+	for _, rest := range data.Restaurants {
+		for _, m := range rest.Menus {
+			var first, last *PriceChange
+			for i := range m.History {
+				if m.History[i].Date < since {
+					continue
+				}
+				if first == nil {
+					first = &m.History[i]
+				}
+				last = &m.History[i]
+			}
+			if first == nil || last.To <= first.From {
+				continue
+			}
+			change := PriceChange{Date: last.Date, From: first.From, To: last.To}
+			increases = append(increases, PriceIncrease{Restaurant: rest.Name, Menu: m.Name, PriceChange: change, Rate: change.Rate()})
+		}
+	}
+	sort.SliceStable(increases, func(i, j int) bool {
+		if increases[i].Rate != increases[j].Rate {
+			return increases[i].Rate > increases[j].Rate
+		}
+		return increases[i].To-increases[i].From > increases[j].To-increases[j].From
+	})
+	if limit > 0 && len(increases) > limit {
+		increases = increases[:limit]
+	}
+	return increases, nil
+}
+
+// 저장할 때 가격 기록에 쓰는 오늘 날짜
+func (s *Service) today() string {
+	return s.now().Format(dateLayout)
+}
@@ -0,0 +1,117 @@
+package restaurant
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTrackMenuPrice(t *testing.T) {
+	prev := Menu{Name: "순대국", Price: 9000, History: []PriceChange{{Date: "2025-01-02", From: 8000, To: 9000}}}
+
+	m := Menu{Name: "순대국", Price: 9500}
+	trackMenuPrice(&m, &prev, "2026-03-02")
+	if len(m.History) != 2 || m.History[1] != (PriceChange{Date: "2026-03-02", From: 9000, To: 9500}) {
+		t.Fatalf("가격 변화가 기록되지 않음: %+v", m.History)
+	}
+	if len(prev.History) != 1 {
+		t.Fatalf("이전 메뉴의 기록이 바뀜: %+v", prev.History)
+	}
+
+	// 같은 날 다시 바꾸면 합치고, 원래 가격으로 돌아오면 기록을 지움
+	again := Menu{Name: "순대국", Price: 10000}
+	trackMenuPrice(&again, &m, "2026-03-02")
+	if len(again.History) != 2 || again.History[1].From != 9000 || again.History[1].To != 10000 {
+		t.Fatalf("같은 날의 변화가 합쳐지지 않음: %+v", again.History)
+	}
+	back := Menu{Name: "순대국", Price: 9000}
+	trackMenuPrice(&back, &again, "2026-03-02")
+	if len(back.History) != 1 {
+		t.Fatalf("되돌린 가격의 기록이 남음: %+v", back.History)
+	}
+
+	unknown := Menu{Name: "순대국"}
+	trackMenuPrice(&unknown, &prev, "2026-03-02")
+	if len(unknown.History) != 1 {
+		t.Fatalf("가격을 지운 것이 기록됨: %+v", unknown.History)
+	}
+}
+
+func TestRepositoryUpdate_TracksPrices(t *testing.T) {
+	s := newTestService(t, pageTestData(), time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+	s.now = func() time.Time { return time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local) }
+
+	rest, err := s.Get("국밥집")
+	if err != nil {
+		t.Fatalf("식당 조회 실패: %v", err)
+	}
+	// 위키처럼 가격 기록 없이 식당 전체를 보냄
+	item := *rest
+	item.Menus = []Menu{{Name: "순대국", Price: 10000}}
+	if err := s.Update("국밥집", item); err != nil {
+		t.Fatalf("수정 실패: %v", err)
+	}
+	item.Menus = []Menu{{Name: "순대국", Price: 10000, Rating: 4}}
+	if err := s.Update("국밥집", item); err != nil {
+		t.Fatalf("수정 실패: %v", err)
+	}
+
+	history, err := s.MenuPrices("국밥집", "순대국")
+	if err != nil {
+		t.Fatalf("가격 기록 조회 실패: %v", err)
+	}
+	if len(history.History) != 1 || history.History[0] != (PriceChange{Date: "2026-03-02", From: 9000, To: 10000}) {
+		t.Fatalf("가격 기록이 잘못됨: %+v", history.History)
+	}
+
+	// 위키의 일괄 저장도 서비스의 시계로 날짜를 기록함
+	s.now = func() time.Time { return time.Date(2026, 3, 5, 12, 0, 0, 0, time.Local) }
+	item.Menus = []Menu{{Name: "순대국", Price: 11000}}
+	if _, err := s.SaveBatch(SaveRequest{Update: []Restaurant{item}}); err != nil {
+		t.Fatalf("일괄 저장 실패: %v", err)
+	}
+	if history, err = s.MenuPrices("국밥집", "순대국"); err != nil {
+		t.Fatalf("가격 기록 조회 실패: %v", err)
+	}
+	if n := len(history.History); n != 2 || history.History[n-1].Date != "2026-03-05" {
+		t.Fatalf("일괄 저장의 가격 기록이 잘못됨: %+v", history.History)
+	}
+}
+
+func TestServicePriceIncreases(t *testing.T) {
+	s := newTestService(t, pageTestData(), time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+	price := 11000
+	s.now = func() time.Time { return time.Date(2025, 12, 1, 12, 0, 0, 0, time.Local) }
+	if _, err := s.UpdateMenu("국밥집", "순대국", MenuPatch{Price: &price}); err != nil {
+		t.Fatalf("메뉴 수정 실패: %v", err)
+	}
+	price = 12000
+	s.now = func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.Local) }
+	if _, err := s.UpdateMenu("라멘집", "돈코츠라멘", MenuPatch{Price: &price}); err != nil {
+		t.Fatalf("메뉴 수정 실패: %v", err)
+	}
+	price = 19000
+	if _, err := s.UpdateMenu("스시집", "모둠초밥", MenuPatch{Price: &price}); err != nil {
+		t.Fatalf("메뉴 수정 실패: %v", err)
+	}
+
+	increases, err := s.PriceIncreases(30, 0)
+	if err != nil {
+		t.Fatalf("가격 상승 조회 실패: %v", err)
+	}
+	if len(increases) != 2 || increases[0].Menu != "돈코츠라멘" || increases[1].Menu != "모둠초밥" {
+		t.Fatalf("가격 상승 순서가 잘못됨: %+v", increases)
+	}
+
+	all, err := s.PriceIncreases(365, 1)
+	if err != nil {
+		t.Fatalf("가격 상승 조회 실패: %v", err)
+	}
+	if len(all) != 1 || all[0].Menu != "순대국" {
+		t.Fatalf("가장 많이 오른 메뉴가 아님: %+v", all)
+	}
+
+	if _, err := s.PriceIncreases(0, 0); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("0일 기간에 ErrInvalid가 아님: %v", err)
+	}
+}
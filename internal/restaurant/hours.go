@@ -0,0 +1,275 @@
+package restaurant
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arch-spatula/jmc/internal/holiday"
+)
+
+// 영업시간
+// Days의 키는 "월"~"일"이고, "평일"(월~금)과 "주말"(토, 일)도 쓸 수 있다. 요일 키가 우선한다.
+// Days에 없는 요일은 휴무다. Days가 비어 있으면 정기 휴무일만 빼고 열려 있다고 본다.
+type OpeningHours struct {
+	Days map[string]DayHours `json:"days"`
+	// 정기 휴무. 예: "매주 월요일", "매월 셋째 화요일", "매월 마지막 일요일", "매월 15일"
+	// "공휴일"은 모든 공휴일, "명절"은 설날·추석 연휴에 쉰다는 뜻이다.
+	Holidays []string `json:"holidays"`
+}
+
+// 하루의 영업시간. 마감이 시작보다 이르면 자정을 넘겨 영업한다.
+type DayHours struct {
+	Open   string      `json:"open"`
+	Close  string      `json:"close"`
+	Breaks []TimeRange `json:"breaks"`
+}
+
+// 브레이크 타임
+type TimeRange struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+var weekdayIndex = map[string][]time.Weekday{
+	"일":  {time.Sunday},
+	"월":  {time.Monday},
+	"화":  {time.Tuesday},
+	"수":  {time.Wednesday},
+	"목":  {time.Thursday},
+	"금":  {time.Friday},
+	"토":  {time.Saturday},
+	"평일": {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	"주말": {time.Saturday, time.Sunday},
+}
+
+var ordinals = map[string]int{"첫째": 1, "둘째": 2, "셋째": 3, "넷째": 4, "다섯째": 5, "마지막": -1}
+
+// "HH:MM"을 자정부터의 분으로 바꿈
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("시간은 HH:MM 형식이어야 합니다: %q", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func (h *OpeningHours) Validate() error {
+	for key, day := range h.Days {
+		if _, ok := weekdayIndex[key]; !ok {
+			return fmt.Errorf("영업시간 요일은 월~일, 평일, 주말 중 하나여야 합니다: %s", key)
+		}
+		if _, err := parseClock(day.Open); err != nil {
+			return fmt.Errorf("%s 영업 시작 %w", key, err)
+		}
+		if _, err := parseClock(day.Close); err != nil {
+			return fmt.Errorf("%s 영업 마감 %w", key, err)
+		}
+		for _, b := range day.Breaks {
+			start, err := parseClock(b.Start)
+			if err != nil {
+				return fmt.Errorf("%s 브레이크 타임 %w", key, err)
+			}
+			end, err := parseClock(b.End)
+			if err != nil {
+				return fmt.Errorf("%s 브레이크 타임 %w", key, err)
+			}
+			if start >= end {
+				return fmt.Errorf("%s 브레이크 타임은 시작이 끝보다 빨라야 합니다: %s-%s", key, b.Start, b.End)
+			}
+		}
+	}
+	for _, holiday := range h.Holidays {
+		if _, err := parseHolidayRule(holiday); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 정기 휴무 규칙
+type holidayRule struct {
+	// "공휴일" 또는 "명절". 비어 있으면 요일/날짜 규칙
+	calendar string
+	weekday  time.Weekday
+	// 0이면 매주, 양수면 그 달의 n번째, -1이면 마지막 주
+	nth int
+	// 0이 아니면 매월 그 날짜
+	day int
+}
+
+func parseHolidayRule(s string) (holidayRule, error) {
+	fields := strings.Fields(s)
+	invalid := fmt.Errorf("정기 휴무 형식을 알 수 없습니다: %q (예: 매주 월요일, 매월 셋째 화요일, 매월 15일, 공휴일, 명절)", s)
+	if len(fields) == 1 && (fields[0] == "공휴일" || fields[0] == "명절") {
+		return holidayRule{calendar: fields[0]}, nil
+	}
+	if len(fields) < 2 {
+		return holidayRule{}, invalid
+	}
+	switch fields[0] {
+	case "매주":
+		if len(fields) != 2 {
+			return holidayRule{}, invalid
+		}
+		wd, ok := parseWeekday(fields[1])
+		if !ok {
+			return holidayRule{}, invalid
+		}
+		return holidayRule{weekday: wd}, nil
+	case "매월":
+		if len(fields) == 2 && strings.HasSuffix(fields[1], "일") {
+			day, err := strconv.Atoi(strings.TrimSuffix(fields[1], "일"))
+			if err != nil || day < 1 || day > 31 {
+				return holidayRule{}, invalid
+			}
+			return holidayRule{day: day}, nil
+		}
+		if len(fields) != 3 {
+			return holidayRule{}, invalid
+		}
+		nth, ok := ordinals[fields[1]]
+		if !ok {
+			return holidayRule{}, invalid
+		}
+		wd, ok := parseWeekday(fields[2])
+		if !ok {
+			return holidayRule{}, invalid
+		}
+		return holidayRule{weekday: wd, nth: nth}, nil
+	}
+	return holidayRule{}, invalid
+}
+
+// "월요일" 또는 "월"
+func parseWeekday(s string) (time.Weekday, bool) {
+	days, ok := weekdayIndex[strings.TrimSuffix(s, "요일")]
+	if !ok || len(days) != 1 {
+		return 0, false
+	}
+	return days[0], true
+}
+
+func (rule holidayRule) matches(t time.Time) bool {
+	switch rule.calendar {
+	case "공휴일":
+		return holiday.IsHoliday(t)
+	case "명절":
+		return holiday.IsMyeongjeol(t)
+	}
+	if rule.day != 0 {
+		return t.Day() == rule.day
+	}
+	if t.Weekday() != rule.weekday {
+		return false
+	}
+	switch {
+	case rule.nth == 0:
+		return true
+	case rule.nth > 0:
+		return (t.Day()-1)/7+1 == rule.nth
+	default:
+		return t.AddDate(0, 0, 7).Month() != t.Month()
+	}
+}
+
+// 정기 휴무일인지 확인함
+func (h *OpeningHours) closedOn(t time.Time) bool {
+	for _, s := range h.Holidays {
+		rule, err := parseHolidayRule(s)
+		if err != nil {
+			continue
+		}
+		if rule.matches(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// 그 요일의 영업시간. 요일 키가 평일/주말 키보다 우선한다.
+func (h *OpeningHours) dayHours(wd time.Weekday) (DayHours, bool) {
+	if day, ok := h.Days[WeekdayName(wd)]; ok {
+		return day, true
+	}
+	group := "평일"
+	if wd == time.Saturday || wd == time.Sunday {
+		group = "주말"
+	}
+	day, ok := h.Days[group]
+	return day, ok
+}
+
+// t 시각에 영업 중인지 확인함
+// 자정을 넘기는 영업은 전날 영업시간의 연장으로 본다.
+func (h *OpeningHours) IsOpen(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	if h.openWithin(t, minute) {
+		return true
+	}
+	// 전날 밤부터 이어지는 영업
+	return h.openWithin(t.AddDate(0, 0, -1), minute+24*60)
+}
+
+// date의 영업시간 안에 minute(그날 자정부터의 분)가 들어가는지 확인함
+func (h *OpeningHours) openWithin(date time.Time, minute int) bool {
+	if h.closedOn(date) {
+		return false
+	}
+	if len(h.Days) == 0 {
+		return minute < 24*60
+	}
+	day, ok := h.dayHours(date.Weekday())
+	if !ok {
+		return false
+	}
+	open, err := parseClock(day.Open)
+	if err != nil {
+		return false
+	}
+	closing, err := parseClock(day.Close)
+	if err != nil {
+		return false
+	}
+	if closing <= open {
+		closing += 24 * 60
+	}
+	if minute < open || minute >= closing {
+		return false
+	}
+	for _, b := range day.Breaks {
+		start, err := parseClock(b.Start)
+		if err != nil {
+			continue
+		}
+		end, err := parseClock(b.End)
+		if err != nil {
+			continue
+		}
+		if minute >= start && minute < end {
+			return false
+		}
+	}
+	return true
+}
+
+// 영업시간 정보가 없으면 열려 있다고 봄
+func (r *Restaurant) OpenAt(t time.Time) bool {
+	if r.Hours == nil {
+		return true
+	}
+	return r.Hours.IsOpen(t)
+}
+
+// 도착 시각을 해석함. "HH:MM"이면 now와 같은 날, "YYYY-MM-DD HH:MM"이면 그 날짜다.
+func ParseArrival(s string, now time.Time) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02 15:04", s, now.Location()); err == nil {
+		return t, nil
+	}
+	minute, err := parseClock(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: 도착 시각은 HH:MM 또는 YYYY-MM-DD HH:MM 형식이어야 합니다: %s", ErrInvalid, s)
+	}
+	return time.Date(now.Year(), now.Month(), now.Day(), minute/60, minute%60, 0, 0, now.Location()), nil
+}
@@ -0,0 +1,138 @@
+package restaurant
+
+import (
+	"testing"
+	"time"
+)
+
+func at(date string, clock string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04", date+" "+clock, time.Local)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func sampleHours() *OpeningHours {
+	return &OpeningHours{
+		Days: map[string]DayHours{
+			"평일": {Open: "11:00", Close: "21:00", Breaks: []TimeRange{{Start: "14:30", End: "17:00"}}},
+			"토":  {Open: "11:00", Close: "02:00"},
+		},
+		Holidays: []string{"매월 셋째 수요일"},
+	}
+}
+
+func TestOpeningHours_IsOpen(t *testing.T) {
+	h := sampleHours()
+	cases := []struct {
+		t    time.Time
+		want bool
+	}{
+		{at("2026-03-02", "12:00"), true},  // 월요일 점심
+		{at("2026-03-02", "10:59"), false}, // 영업 전
+		{at("2026-03-02", "14:30"), false}, // 브레이크 타임
+		{at("2026-03-02", "17:00"), true},  // 브레이크 타임 끝
+		{at("2026-03-02", "21:00"), false}, // 마감
+		{at("2026-03-18", "12:00"), false}, // 셋째 수요일 정기 휴무
+		{at("2026-03-11", "12:00"), true},  // 둘째 수요일
+		{at("2026-03-07", "23:30"), true},  // 토요일 밤
+		{at("2026-03-08", "01:30"), true},  // 토요일 영업이 일요일 새벽까지
+		{at("2026-03-08", "12:00"), false}, // 일요일은 영업시간 없음
+	}
+	for _, c := range cases {
+		if got := h.IsOpen(c.t); got != c.want {
+			t.Fatalf("%s: %v를 기대했지만 %v", c.t.Format("2006-01-02 Mon 15:04"), c.want, got)
+		}
+	}
+}
+
+func TestOpeningHours_OnlyHolidays(t *testing.T) {
+	h := &OpeningHours{Holidays: []string{"매주 월요일", "매월 마지막 금요일"}}
+	if h.IsOpen(at("2026-03-02", "12:00")) {
+		t.Fatal("매주 월요일 휴무인데 열려 있음")
+	}
+	if h.IsOpen(at("2026-03-27", "12:00")) {
+		t.Fatal("마지막 금요일 휴무인데 열려 있음")
+	}
+	if !h.IsOpen(at("2026-03-03", "12:00")) {
+		t.Fatal("영업시간이 없으면 휴무일 외에는 열려 있어야 함")
+	}
+}
+
+func TestOpeningHours_PublicHolidays(t *testing.T) {
+	h := &OpeningHours{Holidays: []string{"명절"}}
+	if h.IsOpen(at("2026-02-17", "12:00")) {
+		t.Fatal("설날인데 열려 있음")
+	}
+	if !h.IsOpen(at("2026-03-02", "12:00")) {
+		t.Fatal("명절만 쉬는데 삼일절 대체공휴일에 닫혀 있음")
+	}
+	h.Holidays = []string{"공휴일"}
+	if h.IsOpen(at("2026-03-02", "12:00")) {
+		t.Fatal("공휴일에 쉬는데 대체공휴일에 열려 있음")
+	}
+}
+
+func TestOpeningHoursValidate(t *testing.T) {
+	if err := sampleHours().Validate(); err != nil {
+		t.Fatalf("유효한 영업시간인데 에러 발생: %v", err)
+	}
+	invalid := []*OpeningHours{
+		{Days: map[string]DayHours{"월요일": {Open: "11:00", Close: "21:00"}}},
+		{Days: map[string]DayHours{"월": {Open: "11시", Close: "21:00"}}},
+		{Holidays: []string{"가끔 쉼"}},
+		{Holidays: []string{"매월 32일"}},
+		{Days: map[string]DayHours{"월": {Open: "11:00", Close: "21:00", Breaks: []TimeRange{{Start: "17:00", End: "15:00"}}}}},
+		{Days: map[string]DayHours{"월": {Open: "11:00", Close: "21:00", Breaks: []TimeRange{{Start: "15:00", End: "15:00"}}}}},
+	}
+	for _, h := range invalid {
+		if err := h.Validate(); err == nil {
+			t.Fatalf("잘못된 영업시간인데 에러가 발생하지 않음: %+v", h)
+		}
+	}
+}
+
+func TestServiceRecommend_SkipsClosedRestaurants(t *testing.T) {
+	list := testRestaurants("점심만", "저녁만")
+	list[0].Hours = &OpeningHours{Days: map[string]DayHours{"평일": {Open: "11:00", Close: "15:00"}}}
+	list[1].Hours = &OpeningHours{Days: map[string]DayHours{"평일": {Open: "17:00", Close: "23:00"}}}
+	s := newTestService(t, &RestaurantData{Restaurants: list}, at("2026-03-02", "11:40"))
+
+	picks, err := s.Recommend(RecommendOptions{Count: 2})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	if len(picks) != 1 || picks[0].Name != "점심만" {
+		t.Fatalf("점심에 여는 식당만 기대했지만 %v", picks)
+	}
+
+	evening := at("2026-03-02", "18:00")
+	picks, err = s.Recommend(RecommendOptions{Count: 2, At: &evening})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	if len(picks) != 1 || picks[0].Name != "저녁만" {
+		t.Fatalf("저녁에 여는 식당만 기대했지만 %v", picks)
+	}
+}
+
+func TestRepositoryUpdate_PreservesHours(t *testing.T) {
+	list := testRestaurants("점심만")
+	list[0].Hours = sampleHours()
+	s := newTestService(t, &RestaurantData{Restaurants: list}, time.Time{})
+
+	// 위키는 영업시간 없이 표의 필드만 보냄
+	edited := testRestaurants("점심만")[0]
+	edited.Rating = 4
+	if _, err := s.SaveBatch(SaveRequest{Update: []Restaurant{edited}}); err != nil {
+		t.Fatalf("저장 실패: %v", err)
+	}
+	data, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("데이터 읽기 실패: %v", err)
+	}
+	if data.Restaurants[0].Hours == nil || data.Restaurants[0].Rating != 4 {
+		t.Fatalf("영업시간이 유지되지 않음: %+v", data.Restaurants[0])
+	}
+}
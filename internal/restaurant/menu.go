@@ -0,0 +1,195 @@
+package restaurant
+
+import "fmt"
+
+// 메뉴의 일부 필드만 바꾸는 요청. nil인 필드는 그대로 둔다.
+type MenuPatch struct {
+	Name        *string  `json:"name"`
+	Rating      *float64 `json:"rating"`
+	Price       *int     `json:"price"`
+	Description *string  `json:"description"`
+	Visited     *bool    `json:"visited"`
+	Diet        *Diet    `json:"diet"`
+}
+
+func (p *MenuPatch) apply(m *Menu) {
+	if p.Name != nil {
+		m.Name = *p.Name
+	}
+	if p.Rating != nil {
+		m.Rating = *p.Rating
+	}
+	if p.Price != nil {
+		m.Price = *p.Price
+	}
+	if p.Description != nil {
+		m.Description = *p.Description
+	}
+	if p.Visited != nil {
+		m.Visited = *p.Visited
+	}
+	if p.Diet != nil {
+		m.Diet = p.Diet
+	}
+}
+
+// 메뉴 이름으로 메뉴의 위치를 찾음. 공백과 대소문자 차이는 무시한다.
+func (r *Restaurant) menuIndex(name string) int {
+	key := termKey(name)
+	for i := range r.Menus {
+		if termKey(r.Menus[i].Name) == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// 메뉴를 검증하고 skip번째가 아닌 메뉴와 이름이 겹치는지 확인함
+func (r *Restaurant) checkMenu(m *Menu, skip int) error {
+	m.Name = cleanTerm(m.Name)
+	if err := m.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+	if i := r.menuIndex(m.Name); i >= 0 && i != skip {
+		return fmt.Errorf("%w: 이미 있는 메뉴입니다: %s", ErrInvalid, r.Menus[i].Name)
+	}
+	return nil
+}
+
+func (r *Restaurant) findMenu(name string) (int, error) {
+	i := r.menuIndex(name)
+	if i < 0 {
+		return -1, fmt.Errorf("%w: %s의 %s", ErrMenuNotFound, r.Name, name)
+	}
+	return i, nil
+}
+
+// 메뉴 이름이 바뀌면 방문 기록의 메뉴도 함께 바꿈
+func (d *RestaurantData) renameVisitMenus(restaurant, from, to string) {
+	if from == to {
+		return
+	}
+	for i := range d.Visits {
+		if d.Visits[i].Restaurant != restaurant {
+			continue
+		}
+		for j, menu := range d.Visits[i].Menus {
+			if menu == from {
+				d.Visits[i].Menus[j] = to
+			}
+		}
+	}
+}
+
+// 식당의 메뉴 목록
+func (s *Service) Menus(name string) ([]Menu, error) {
+	rest, err := s.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if rest.Menus == nil {
+		return []Menu{}, nil
+	}
+	return rest.Menus, nil
+}
+
+// 메뉴를 맨 뒤에 추가함
+func (s *Service) AddMenu(name string, menu Menu) (*Menu, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	rest, err := data.findRestaurant(name)
+	if err != nil {
+		return nil, err
+	}
+	// 가격 기록은 가격을 고칠 때만 쌓이므로 요청에 담긴 기록은 버림
+	menu.History = nil
+	if err := rest.checkMenu(&menu, -1); err != nil {
+		return nil, err
+	}
+	rest.Menus = append(rest.Menus, menu)
+	if err := s.repo.Save(data); err != nil {
+		return nil, err
+	}
+	return &menu, nil
+}
+
+// 메뉴의 일부 필드를 바꿈
+func (s *Service) UpdateMenu(name, menuName string, patch MenuPatch) (*Menu, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	rest, err := data.findRestaurant(name)
+	if err != nil {
+		return nil, err
+	}
+	i, err := rest.findMenu(menuName)
+	if err != nil {
+		return nil, err
+	}
+	menu := rest.Menus[i]
+	menu.History = nil
+	patch.apply(&menu)
+	if err := rest.checkMenu(&menu, i); err != nil {
+		return nil, err
+	}
+	trackMenuPrice(&menu, &rest.Menus[i], s.today())
+	data.renameVisitMenus(rest.Name, rest.Menus[i].Name, menu.Name)
+	rest.Menus[i] = menu
+	if err := s.repo.Save(data); err != nil {
+		return nil, err
+	}
+	return &menu, nil
+}
+
+func (s *Service) DeleteMenu(name, menuName string) error {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return err
+	}
+	rest, err := data.findRestaurant(name)
+	if err != nil {
+		return err
+	}
+	i, err := rest.findMenu(menuName)
+	if err != nil {
+		return err
+	}
+	rest.Menus = append(rest.Menus[:i], rest.Menus[i+1:]...)
+	return s.repo.Save(data)
+}
+
+// 메뉴 순서를 바꿈. names는 지금 있는 메뉴 이름을 빠짐없이 한 번씩 담아야 한다.
+func (s *Service) ReorderMenus(name string, names []string) ([]Menu, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	rest, err := data.findRestaurant(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) != len(rest.Menus) {
+		return nil, fmt.Errorf("%w: 메뉴 %d개의 순서가 모두 필요합니다 (받은 이름 %d개)", ErrInvalid, len(rest.Menus), len(names))
+	}
+	ordered := make([]Menu, 0, len(names))
+	used := make([]bool, len(rest.Menus))
+	for _, n := range names {
+		i, err := rest.findMenu(n)
+		if err != nil {
+			return nil, err
+		}
+		if used[i] {
+			return nil, fmt.Errorf("%w: 메뉴가 두 번 들어 있습니다: %s", ErrInvalid, n)
+		}
+		used[i] = true
+		ordered = append(ordered, rest.Menus[i])
+	}
+	rest.Menus = ordered
+	if err := s.repo.Save(data); err != nil {
+		return nil, err
+	}
+	return ordered, nil
+}
@@ -0,0 +1,127 @@
+package restaurant
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func menuNames(menus []Menu) []string {
+	names := make([]string, 0, len(menus))
+	for _, m := range menus {
+		names = append(names, m.Name)
+	}
+	return names
+}
+
+func TestServiceAddMenu(t *testing.T) {
+	s := newTestService(t, pageTestData(), time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+
+	fake := []PriceChange{{Date: "2026-01-01", From: 5000, To: 11000}}
+	added, err := s.AddMenu("국밥집", Menu{Name: "  수육  국밥 ", Price: 11000, History: fake})
+	if err != nil {
+		t.Fatalf("메뉴 추가 실패: %v", err)
+	}
+	if added.Name != "수육 국밥" {
+		t.Fatalf("메뉴 이름이 정리되지 않음: %q", added.Name)
+	}
+	if added.History != nil {
+		t.Fatalf("요청에 담긴 가격 기록이 저장됨: %+v", added.History)
+	}
+	menus, err := s.Menus("국밥집")
+	if err != nil {
+		t.Fatalf("메뉴 조회 실패: %v", err)
+	}
+	if got := menuNames(menus); len(got) != 2 || got[1] != "수육 국밥" {
+		t.Fatalf("메뉴가 맨 뒤에 추가되지 않음: %q", got)
+	}
+
+	cases := []struct {
+		restaurant string
+		menu       Menu
+		want       error
+	}{
+		{"국밥집", Menu{Name: "순대국"}, ErrInvalid},
+		{"국밥집", Menu{Name: "수육국밥", Rating: 4.3}, ErrInvalid},
+		{"국밥집", Menu{Name: ""}, ErrInvalid},
+		{"없는 식당", Menu{Name: "김밥"}, ErrNotFound},
+	}
+	for _, tc := range cases {
+		if _, err := s.AddMenu(tc.restaurant, tc.menu); !errors.Is(err, tc.want) {
+			t.Errorf("%s %q: %v, 기대값 %v", tc.restaurant, tc.menu.Name, err, tc.want)
+		}
+	}
+}
+
+func TestServiceUpdateMenu(t *testing.T) {
+	s := newTestService(t, pageTestData(), time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+	if _, err := s.Accept("국밥집", Visit{Menus: []string{"순대국"}}); err != nil {
+		t.Fatalf("방문 기록 실패: %v", err)
+	}
+
+	price, name := 9500, "순댓국"
+	updated, err := s.UpdateMenu("국밥집", "순대국", MenuPatch{Price: &price, Name: &name})
+	if err != nil {
+		t.Fatalf("메뉴 수정 실패: %v", err)
+	}
+	if updated.Name != "순댓국" || updated.Price != 9500 || !updated.Visited {
+		t.Fatalf("주지 않은 필드가 바뀌었거나 수정되지 않음: %+v", updated)
+	}
+
+	data, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("데이터 읽기 실패: %v", err)
+	}
+	if visit := data.Visits[len(data.Visits)-1]; visit.Menus[0] != "순댓국" {
+		t.Fatalf("방문 기록의 메뉴 이름이 바뀌지 않음: %q", visit.Menus)
+	}
+
+	if _, err := s.UpdateMenu("국밥집", "없는 메뉴", MenuPatch{Price: &price}); !errors.Is(err, ErrMenuNotFound) {
+		t.Fatalf("없는 메뉴에 ErrMenuNotFound가 아님: %v", err)
+	}
+	negative := -1
+	if _, err := s.UpdateMenu("국밥집", "순댓국", MenuPatch{Price: &negative}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("음수 가격에 ErrInvalid가 아님: %v", err)
+	}
+}
+
+func TestServiceDeleteAndReorderMenus(t *testing.T) {
+	s := newTestService(t, pageTestData(), time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+	for _, name := range []string{"수육", "모둠전"} {
+		if _, err := s.AddMenu("국밥집", Menu{Name: name}); err != nil {
+			t.Fatalf("메뉴 추가 실패: %v", err)
+		}
+	}
+
+	menus, err := s.ReorderMenus("국밥집", []string{"모둠전", "순대국", "수육"})
+	if err != nil {
+		t.Fatalf("순서 바꾸기 실패: %v", err)
+	}
+	if got := menuNames(menus); !sameNames(got, []string{"모둠전", "순대국", "수육"}) {
+		t.Fatalf("순서가 바뀌지 않음: %q", got)
+	}
+	for _, names := range [][]string{{"모둠전", "순대국"}, {"모둠전", "모둠전", "수육"}} {
+		if _, err := s.ReorderMenus("국밥집", names); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%q: ErrInvalid가 아님: %v", names, err)
+		}
+	}
+
+	if err := s.DeleteMenu("국밥집", "순대국"); err != nil {
+		t.Fatalf("메뉴 삭제 실패: %v", err)
+	}
+	menus, err = s.Menus("국밥집")
+	if err != nil {
+		t.Fatalf("메뉴 조회 실패: %v", err)
+	}
+	if got := menuNames(menus); !sameNames(got, []string{"모둠전", "수육"}) {
+		t.Fatalf("메뉴가 삭제되지 않음: %q", got)
+	}
+}
+
+func TestRestaurantValidate_DuplicateMenu(t *testing.T) {
+	r := testRestaurants("국밥집")[0]
+	r.Menus = []Menu{{Name: "순대국"}, {Name: "순대국 "}}
+	if err := r.Validate(); err == nil {
+		t.Fatal("중복된 메뉴 이름이 검증을 통과함")
+	}
+}
@@ -0,0 +1,108 @@
+package restaurant
+
+import "fmt"
+
+// 추천 모드
+// 모드마다 점수 요인의 가중치가 다르다. cli_config.modes에 원하는 만큼 추가할 수 있다.
+type Mode struct {
+	Name string `json:"name"`
+	// 추천 전략: random, weighted(기본값), bandit
+	Strategy string `json:"strategy"`
+	// 식당 rating 1점당 가산점
+	RatingWeight float64 `json:"rating_weight"`
+	// 마지막 방문 후 CooldownDays일 동안 감점. 방문 직후가 가장 크고 점점 줄어든다.
+	CooldownDays    int     `json:"cooldown_days"`
+	CooldownPenalty float64 `json:"cooldown_penalty"`
+	// 한 번도 가보지 않은 식당의 가산점
+	NoveltyBonus float64 `json:"novelty_bonus"`
+	// 선택된 검색 필터(search.selected)에 맞는 식당의 가산점
+	FilterBonus float64 `json:"filter_bonus"`
+	Rules       []Rule  `json:"rules"`
+	// 최근에 먹은 카테고리를 피하는 설정
+	Diversity Diversity `json:"diversity"`
+}
+
+// 계절 규칙
+// 날씨는 오프라인에서 알 수 없으므로 월 단위로만 판단한다. 예: 여름에는 냉면
+type Rule struct {
+	Name       string   `json:"name"`
+	Months     []int    `json:"months"`
+	Categories []string `json:"categories"`
+	Bonus      float64  `json:"bonus"`
+}
+
+// 설정에 모드가 없을 때 쓰는 기본 모드
+func DefaultModes() []Mode {
+	return []Mode{
+		{
+			Name:            "일상",
+			RatingWeight:    1,
+			CooldownDays:    7,
+			CooldownPenalty: 4,
+			NoveltyBonus:    0,
+			FilterBonus:     2,
+			Diversity:       Diversity{Lookback: 3, Penalty: 1.5},
+		},
+		{
+			Name:            "탐방",
+			RatingWeight:    0.3,
+			CooldownDays:    14,
+			CooldownPenalty: 4,
+			NoveltyBonus:    3,
+			FilterBonus:     2,
+			Diversity:       Diversity{Lookback: 5, Penalty: 1},
+		},
+		{
+			Name:            "밴딧",
+			Strategy:        StrategyBandit,
+			CooldownDays:    7,
+			CooldownPenalty: 4,
+			FilterBonus:     2,
+			Diversity:       Diversity{Lookback: 3, Penalty: 1.5},
+		},
+	}
+}
+
+func (m *Mode) Validate() error {
+	if m.Name == "" {
+		return fmt.Errorf("모드 name은 필수입니다")
+	}
+	if m.Strategy != "" && !contains(strategies, m.Strategy) {
+		return fmt.Errorf("모드 strategy는 %v 중 하나여야 합니다: %s", strategies, m.Name)
+	}
+	if m.CooldownDays < 0 {
+		return fmt.Errorf("모드 cooldown_days는 0 이상이어야 합니다: %s", m.Name)
+	}
+	if err := m.Diversity.Validate(); err != nil {
+		return fmt.Errorf("%w: %s", err, m.Name)
+	}
+	for _, rule := range m.Rules {
+		for _, month := range rule.Months {
+			if month < 1 || month > 12 {
+				return fmt.Errorf("모드 규칙의 months는 1~12 사이여야 합니다: %s", m.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// 이름으로 모드를 찾음
+// 이름이 비어 있으면 cli_config.mode를, 그것도 없으면 첫 번째 모드를 사용한다.
+func (c *CLIConfig) FindMode(name string) (*Mode, error) {
+	modes := c.Modes
+	if len(modes) == 0 {
+		modes = DefaultModes()
+	}
+	if name == "" {
+		name = c.Mode
+	}
+	if name == "" {
+		return &modes[0], nil
+	}
+	for i := range modes {
+		if modes[i].Name == name {
+			return &modes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: 모드를 찾을 수 없습니다: %s", ErrInvalid, name)
+}
@@ -1,6 +1,21 @@
 package restaurant
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arch-spatula/jmc/internal/geo"
+	"github.com/arch-spatula/jmc/internal/kakao"
+)
+
+var (
+	ErrNotFound = errors.New("식당을 찾을 수 없습니다")
+	ErrInvalid  = errors.New("잘못된 요청입니다")
+	// 식당은 있지만 메뉴가 없을 때
+	ErrMenuNotFound    = errors.New("메뉴를 찾을 수 없습니다")
+	ErrProfileNotFound = errors.New("식단 프로필을 찾을 수 없습니다")
+)
 
 type Menu struct {
 	Name        string  `json:"name"`
@@ -8,6 +23,10 @@ type Menu struct {
 	Price       int     `json:"price"`
 	Description string  `json:"description"`
 	Visited     bool    `json:"visited"`
+	// 가격이 바뀐 기록. 오래된 것부터
+	History []PriceChange `json:"price_history,omitempty"`
+	// 식단 정보. 없으면 식당의 식단 정보를 따른다.
+	Diet *Diet `json:"diet,omitempty"`
 }
 
 func (m *Menu) Validate() error {
@@ -23,18 +42,62 @@ func (m *Menu) Validate() error {
 	if m.Price < 0 {
 		return fmt.Errorf("메뉴 price는 0 이상이어야 합니다: %s", m.Name)
 	}
+	if m.Diet != nil {
+		if err := m.Diet.Validate(); err != nil {
+			return fmt.Errorf("메뉴 diet: %s: %w", m.Name, err)
+		}
+	}
+	return nil
+}
+
+// 방문 기록
+type Visit struct {
+	Restaurant string   `json:"restaurant"`
+	Date       string   `json:"date"`
+	Menus      []string `json:"menus"`
+	Spend      int      `json:"spend"`
+	Rating     float64  `json:"rating"`
+}
+
+func (v *Visit) Validate() error {
+	if v.Restaurant == "" {
+		return fmt.Errorf("방문 restaurant는 필수입니다")
+	}
+	if _, err := time.Parse(dateLayout, v.Date); err != nil {
+		return fmt.Errorf("방문 date는 YYYY-MM-DD 형식이어야 합니다: %s", v.Restaurant)
+	}
+	if v.Spend < 0 {
+		return fmt.Errorf("방문 spend는 0 이상이어야 합니다: %s", v.Restaurant)
+	}
+	if v.Rating < 0 || v.Rating > 5 {
+		return fmt.Errorf("방문 rating은 0~5 사이여야 합니다: %s", v.Restaurant)
+	}
+	if v.Rating*2 != float64(int(v.Rating*2)) {
+		return fmt.Errorf("방문 rating은 0.5 단위여야 합니다: %s", v.Restaurant)
+	}
 	return nil
 }
 
 type Restaurant struct {
-	Name        string   `json:"name"`
-	Rating      float64  `json:"rating"`
-	Categories  []string `json:"categories"`
-	Locations   []string `json:"locations"`
-	KakaoURL    string   `json:"kakao_url"`
-	Visited     bool     `json:"visited"`
-	Description string   `json:"description"`
-	Menus       []Menu   `json:"menus"`
+	Name       string   `json:"name"`
+	Rating     float64  `json:"rating"`
+	Categories []string `json:"categories"`
+	Locations  []string `json:"locations"`
+	// 카카오맵 장소 URL. 저장할 때 https://place.map.kakao.com/<장소 ID>로 정규화한다.
+	KakaoURL    string `json:"kakao_url"`
+	Visited     bool   `json:"visited"`
+	Description string `json:"description"`
+	Menus       []Menu `json:"menus"`
+	// 영업시간. 없으면 항상 열려 있다고 본다.
+	Hours *OpeningHours `json:"hours,omitempty"`
+	// 위도/경도. 없으면 거리를 알 수 없다.
+	Coord *geo.Point `json:"coord,omitempty"`
+	// 주소. 카카오맵에서 가져왔을 때 채운다.
+	Address string `json:"address,omitempty"`
+	// 식단 정보가 없는 메뉴에 적용하는 식당 전체의 식단 정보
+	Diet *Diet `json:"diet,omitempty"`
+	// 폐업했거나 더 가지 않는 식당. 기록은 남기고 추천에서만 뺀다.
+	Archived bool `json:"archived,omitempty"`
 }
 
 func (r *Restaurant) Validate() error {
@@ -55,10 +118,33 @@ func (r *Restaurant) Validate() error {
 		return fmt.Errorf("locations 필드는 필수입니다: %s", r.Name)
 	}
 	// 카카오톡 맵 url은 없어도 됨(빈문자열로 저장)
+	if r.KakaoURL != "" {
+		if _, ok := kakao.PlaceID(r.KakaoURL); !ok {
+			return fmt.Errorf("kakao_url은 카카오맵 장소 URL이어야 합니다 (짧은 링크는 브라우저에서 열어 나온 주소를 쓰세요): %s", r.KakaoURL)
+		}
+	}
+	if r.Coord != nil {
+		if err := r.Coord.Validate(); err != nil {
+			return fmt.Errorf("%s coord: %w", r.Name, err)
+		}
+	}
+	if r.Hours != nil {
+		if err := r.Hours.Validate(); err != nil {
+			return fmt.Errorf("%s hours: %w", r.Name, err)
+		}
+	}
+	if r.Diet != nil {
+		if err := r.Diet.Validate(); err != nil {
+			return fmt.Errorf("%s diet: %w", r.Name, err)
+		}
+	}
 	for i, m := range r.Menus {
 		if err := m.Validate(); err != nil {
 			return fmt.Errorf("%s menus[%d]: %w", r.Name, i, err)
 		}
+		if j := r.menuIndex(m.Name); j != i {
+			return fmt.Errorf("%s menus[%d]: 메뉴 이름이 중복됩니다: %s", r.Name, i, m.Name)
+		}
 	}
 	return nil
 }
@@ -72,11 +158,93 @@ func (d *RestaurantData) Validate() error {
 			return fmt.Errorf("restaurants[%d]: %w", i, err)
 		}
 	}
+	for i, m := range d.CLIConfig.Modes {
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("cli_config.modes[%d]: %w", i, err)
+		}
+	}
+	if err := validateTerms(d.Regions, map[string]bool{}); err != nil {
+		return fmt.Errorf("regions: %w", err)
+	}
+	if err := validateTerms(d.Categories, map[string]bool{}); err != nil {
+		return fmt.Errorf("categories: %w", err)
+	}
+	for i, f := range d.Search.Filters {
+		if _, err := ParseQuery(f.Query); err != nil {
+			return fmt.Errorf("search.filters[%d]: %w", i, err)
+		}
+	}
+	switch d.CLIConfig.NoMenuPolicy {
+	case "", NoMenuInclude, NoMenuExclude, NoMenuCategory:
+	default:
+		return fmt.Errorf("cli_config.no_menu_policy는 include, exclude, category 중 하나여야 합니다: %s", d.CLIConfig.NoMenuPolicy)
+	}
+	seen := map[string]bool{}
+	for i := range d.Profiles {
+		if err := d.Profiles[i].Validate(); err != nil {
+			return fmt.Errorf("profiles[%d]: %w", i, err)
+		}
+		key := termKey(d.Profiles[i].Name)
+		if seen[key] {
+			return fmt.Errorf("profiles[%d]: 프로필 이름이 중복됩니다: %s", i, d.Profiles[i].Name)
+		}
+		seen[key] = true
+	}
+	for _, name := range d.CLIConfig.DefaultProfiles {
+		if _, err := d.findProfile(name); err != nil {
+			return fmt.Errorf("cli_config.default_profiles: %w", err)
+		}
+	}
+	if d.CLIConfig.MonthlyBudget < 0 {
+		return fmt.Errorf("cli_config.monthly_budget는 0 이상이어야 합니다: %d", d.CLIConfig.MonthlyBudget)
+	}
+	for category, price := range d.CLIConfig.CategoryPrices {
+		if price <= 0 {
+			return fmt.Errorf("cli_config.category_prices의 가격은 0보다 커야 합니다: %s", category)
+		}
+	}
+	for i, o := range d.CLIConfig.Origins {
+		if err := o.Validate(); err != nil {
+			return fmt.Errorf("cli_config.origins[%d]: %w", i, err)
+		}
+	}
+	for i, v := range d.Visits {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("visits[%d]: %w", i, err)
+		}
+	}
+	if d.Plan != nil {
+		if err := d.Plan.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 type CLIConfig struct {
 	Port int `json:"port"`
+	// 오늘의 추천 시드에 섞는 팀 공용 비밀값
+	TeamSecret string `json:"team_secret"`
+	// 기본 추천 모드 이름과 모드 목록. 목록이 비어 있으면 DefaultModes를 쓴다.
+	Mode  string `json:"mode"`
+	Modes []Mode `json:"modes"`
+	// 거리를 재는 기준 위치 목록. 첫 번째가 기본값이다.
+	Origins []Origin `json:"origins"`
+	// 걷는 속도 (분당 미터). 0이면 약 4km/h로 본다.
+	WalkSpeed float64 `json:"walk_speed"`
+	// 예산으로 거를 때 메뉴 가격을 모르는 식당을 다루는 방법. include(기본값), exclude, category
+	NoMenuPolicy string `json:"no_menu_policy,omitempty"`
+	// no_menu_policy가 category일 때 쓰는 카테고리별 한 끼 가격
+	CategoryPrices map[string]int `json:"category_prices,omitempty"`
+	// 한 달 식대 한도. 0이면 한도가 없다.
+	MonthlyBudget int `json:"monthly_budget,omitempty"`
+	// 추천할 때 따로 고르지 않으면 적용하는 식단 프로필 이름
+	DefaultProfiles []string `json:"default_profiles,omitempty"`
+	// 카카오 REST API 키. 환경 변수 KAKAO_REST_API_KEY가 있으면 그것을 쓴다.
+	KakaoAPIKey string `json:"kakao_api_key,omitempty"`
+	// 카카오 로컬 API와 장소 페이지 주소. 비어 있으면 실제 카카오 서버를 쓴다.
+	KakaoBaseURL  string `json:"kakao_base_url,omitempty"`
+	KakaoPlaceURL string `json:"kakao_place_url,omitempty"`
 }
 
 type SearchFilter struct {
@@ -84,6 +252,10 @@ type SearchFilter struct {
 	Categories []string `json:"categories"`
 	SortBy     string   `json:"sort_by"`
 	Visited    *bool    `json:"visited"`
+	// 상위 지역을 고르면 하위 지역의 식당도 맞는다.
+	Locations []string `json:"locations"`
+	// rating>=4 -visited 같은 검색식. 위의 조건과 함께 모두 맞아야 한다.
+	Query string `json:"query,omitempty"`
 }
 
 type Search struct {
@@ -92,13 +264,23 @@ type Search struct {
 }
 
 type RestaurantData struct {
-	Restaurants []Restaurant `json:"restaurants"`
-	CLIConfig   CLIConfig    `json:"cli_config"`
-	Search      Search       `json:"search"`
+	Restaurants []Restaurant   `json:"restaurants"`
+	CLIConfig   CLIConfig      `json:"cli_config"`
+	Search      Search         `json:"search"`
+	Recommend   RecommendState `json:"recommend"`
+	Visits      []Visit        `json:"visits"`
+	Plan        *Plan          `json:"plan"`
+	Regions     []Region       `json:"regions"`
+	Categories  []Category     `json:"categories"`
+	Bills       []Bill         `json:"bills,omitempty"`
+	Payments    []Transfer     `json:"payments,omitempty"`
+	Profiles    []DietProfile  `json:"profiles,omitempty"`
 }
 
 type SaveRequest struct {
 	New    []Restaurant `json:"new"`
 	Update []Restaurant `json:"update"`
 	Delete []string     `json:"delete"`
+	// 이름을 바꾼 식당의 원래 이름 → 새 이름. Update의 항목은 새 이름으로 온다.
+	Renames map[string]string `json:"renames,omitempty"`
 }
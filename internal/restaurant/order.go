@@ -0,0 +1,157 @@
+package restaurant
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// 메뉴 추천 모드
+const (
+	// 평점, 주문 횟수, 새로움을 고루 봄
+	OrderBalanced = ""
+	// 늘 먹던 메뉴. 많이 주문한 메뉴부터
+	OrderUsual = "usual"
+	// 안 먹어 본 메뉴만
+	OrderNew = "new"
+)
+
+// 평점이 없는 메뉴는 보통으로 봄
+const neutralMenuRating = 3
+
+type OrderOptions struct {
+	// OrderBalanced, OrderUsual, OrderNew
+	Mode string
+	// 0보다 크면 이 가격을 넘는 메뉴는 빼고 추천한다. 가격을 모르는 메뉴는 남긴다.
+	Budget int
+	// 추천받을 메뉴 수. 0 이하면 1개
+	Count int
+	// 먹는 사람의 식단 프로필 이름. 이 사람들이 먹을 수 없는 메뉴는 빼고 추천한다.
+	// 비어 있으면 cli_config.default_profiles를 쓴다.
+	Profiles []string
+
+	diet *DietProfile
+}
+
+// 추천 메뉴
+type MenuPick struct {
+	Menu
+	Score float64 `json:"score"`
+	// 방문 기록에서 이 메뉴를 주문한 횟수
+	Orders int    `json:"orders"`
+	Reason string `json:"reason"`
+}
+
+func (opts *OrderOptions) validate() error {
+	switch opts.Mode {
+	case OrderBalanced, OrderUsual, OrderNew:
+	default:
+		return fmt.Errorf("%w: 메뉴 추천 모드는 usual 또는 new여야 합니다: %s", ErrInvalid, opts.Mode)
+	}
+	if opts.Budget < 0 {
+		return fmt.Errorf("%w: 예산은 0 이상이어야 합니다: %d", ErrInvalid, opts.Budget)
+	}
+	if opts.Count <= 0 {
+		opts.Count = 1
+	}
+	return nil
+}
+
+// 식당의 메뉴별 주문 횟수. 메뉴 이름의 키로 센다.
+func (d *RestaurantData) menuOrders(restaurant string) map[string]int {
+	orders := map[string]int{}
+	for _, v := range d.Visits {
+		if v.Restaurant != restaurant {
+			continue
+		}
+		for _, menu := range v.Menus {
+			orders[termKey(menu)]++
+		}
+	}
+	return orders
+}
+
+// 식당의 메뉴를 모드에 맞춰 점수가 높은 순서로 추천함
+// 점수가 같으면 싼 메뉴, 그다음 메뉴판 순서가 먼저다.
+func (d *RestaurantData) pickMenus(rest *Restaurant, opts OrderOptions) []MenuPick {
+	orders := d.menuOrders(rest.Name)
+	picks := []MenuPick{}
+	for _, m := range rest.Menus {
+		if opts.Budget > 0 && m.Price > opts.Budget {
+			continue
+		}
+		if !opts.diet.allows(rest.menuDiet(&m)) {
+			continue
+		}
+		n := orders[termKey(m.Name)]
+		tried := n > 0 || m.Visited
+		rating := m.Rating
+		if rating == 0 {
+			rating = neutralMenuRating
+		}
+
+		pick := MenuPick{Menu: m, Orders: n}
+		switch opts.Mode {
+		case OrderUsual:
+			if !tried {
+				continue
+			}
+			// 주문 횟수가 먼저고 평점은 같은 횟수끼리 순서를 정함
+			pick.Score = float64(max(n, 1)) + rating/10
+			pick.Reason = fmt.Sprintf("%d번 주문", n)
+			if n == 0 {
+				pick.Reason = "먹어 본 메뉴"
+			}
+		case OrderNew:
+			if tried {
+				continue
+			}
+			pick.Score = rating
+			pick.Reason = "안 먹어 본 메뉴"
+		default:
+			pick.Score = rating + 0.3*math.Min(float64(n), 3)
+			switch {
+			case !tried:
+				pick.Score += 0.5
+				pick.Reason = "안 먹어 본 메뉴"
+			case n > 0:
+				pick.Reason = fmt.Sprintf("%d번 주문", n)
+			default:
+				pick.Reason = "먹어 본 메뉴"
+			}
+		}
+		if m.Rating > 0 {
+			pick.Reason += fmt.Sprintf(", 평점 %.1f", m.Rating)
+		}
+		picks = append(picks, pick)
+	}
+	sort.SliceStable(picks, func(i, j int) bool {
+		if picks[i].Score != picks[j].Score {
+			return picks[i].Score > picks[j].Score
+		}
+		return picks[i].Price < picks[j].Price
+	})
+	if len(picks) > opts.Count {
+		picks = picks[:opts.Count]
+	}
+	return picks
+}
+
+// 식당에서 주문할 메뉴를 추천함
+func (s *Service) PickMenu(name string, opts OrderOptions) ([]MenuPick, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	rest, err := data.findRestaurant(name)
+	if err != nil {
+		return nil, err
+	}
+	if opts.diet, err = data.dietFor(opts.Profiles); err != nil {
+		return nil, err
+	}
+	return data.pickMenus(rest, opts), nil
+}
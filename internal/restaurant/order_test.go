@@ -0,0 +1,87 @@
+package restaurant
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func orderTestData() *RestaurantData {
+	rest := testRestaurants("국밥집")[0]
+	rest.Menus = []Menu{
+		{Name: "순대국", Rating: 4, Price: 9000},
+		{Name: "수육", Rating: 4.5, Price: 25000},
+		{Name: "뼈해장국", Price: 10000},
+		{Name: "모둠전", Rating: 3, Price: 15000, Visited: true},
+	}
+	return &RestaurantData{
+		Restaurants: []Restaurant{rest},
+		Visits: []Visit{
+			{Restaurant: "국밥집", Date: "2026-03-01", Menus: []string{"순대국"}},
+			{Restaurant: "국밥집", Date: "2026-03-02", Menus: []string{"순대국", "수육"}},
+			{Restaurant: "다른 집", Date: "2026-03-03", Menus: []string{"수육", "수육"}},
+		},
+	}
+}
+
+func pickNames(picks []MenuPick) []string {
+	names := make([]string, 0, len(picks))
+	for _, p := range picks {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+func TestPickMenus_Modes(t *testing.T) {
+	data := orderTestData()
+	rest := &data.Restaurants[0]
+	cases := []struct {
+		opts OrderOptions
+		want []string
+	}{
+		{OrderOptions{Mode: OrderUsual, Count: 5}, []string{"순대국", "수육", "모둠전"}},
+		{OrderOptions{Mode: OrderNew, Count: 5}, []string{"뼈해장국"}},
+		{OrderOptions{Count: 5}, []string{"수육", "순대국", "뼈해장국", "모둠전"}},
+		{OrderOptions{Budget: 12000, Count: 5}, []string{"순대국", "뼈해장국"}},
+		{OrderOptions{Mode: OrderUsual, Count: 1}, []string{"순대국"}},
+	}
+	for _, tc := range cases {
+		if got := pickNames(data.pickMenus(rest, tc.opts)); !sameNames(got, tc.want) {
+			t.Errorf("%+v: %q, 기대값 %q", tc.opts, got, tc.want)
+		}
+	}
+}
+
+func TestPickMenus_CountsOnlyThisRestaurant(t *testing.T) {
+	data := orderTestData()
+	picks := data.pickMenus(&data.Restaurants[0], OrderOptions{Mode: OrderUsual, Count: 5})
+	for _, p := range picks {
+		if p.Name == "수육" && p.Orders != 1 {
+			t.Fatalf("다른 식당의 주문까지 셈: %d번", p.Orders)
+		}
+	}
+}
+
+func TestServicePickMenu_Invalid(t *testing.T) {
+	s := newTestService(t, pageTestData(), time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+	if _, err := s.PickMenu("국밥집", OrderOptions{Mode: "random"}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("잘못된 모드에 ErrInvalid가 아님: %v", err)
+	}
+	if _, err := s.PickMenu("없는 식당", OrderOptions{}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("없는 식당에 ErrNotFound가 아님: %v", err)
+	}
+}
+
+func TestServiceRecommend_IncludesOrder(t *testing.T) {
+	s := newTestService(t, pageTestData(), time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+	seed := int64(1)
+	picks, err := s.Recommend(RecommendOptions{Count: 4, Seed: &seed})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	for _, p := range picks {
+		if len(p.Menus) > 0 && (p.Order == nil || p.Order.Name != p.Menus[0].Name) {
+			t.Fatalf("%s의 추천 메뉴가 없음: %+v", p.Name, p.Order)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package restaurant
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 500
+)
+
+// 정렬 기준별 기본 순서. 평점과 최근 방문은 큰 값부터 본다.
+var pageSortOrders = map[string]string{
+	"name":       "asc",
+	"rating":     "desc",
+	"price":      "asc",
+	"last_visit": "desc",
+	"distance":   "asc",
+}
+
+// 식당 목록 API의 조회 조건
+type PageOptions struct {
+	// 검색식 (예: rating>=4 category:한식)
+	Query string
+	// 저장된 검색 필터 이름. 비어 있으면 필터를 쓰지 않는다.
+	Filter string
+	// name, rating, price(가장 싼 메뉴), last_visit, distance. 비어 있으면 name
+	Sort string
+	// asc 또는 desc. 비어 있으면 정렬 기준의 기본 순서
+	Order string
+	// 거리를 잴 기준 위치 이름. 비어 있으면 첫 번째 기준 위치
+	From string
+	// 한 번에 받을 식당 수. 0이면 50개
+	Limit int
+	// 이전 응답의 next_cursor. 비어 있으면 처음부터
+	Cursor string
+}
+
+// 식당 목록 API의 응답
+type Page struct {
+	Items []Listing `json:"items"`
+	// 조건에 맞는 전체 식당 수
+	Total int `json:"total"`
+	// 다음 쪽을 받을 때 cursor로 넘기는 값. 마지막 쪽이면 비어 있다.
+	NextCursor string `json:"next_cursor"`
+}
+
+// 커서는 조건에 맞는 목록에서의 위치를 감싼 값이다.
+// 조회 사이에 식당이 추가되거나 삭제되면 한 쪽의 경계가 밀릴 수 있다.
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("%w: 잘못된 cursor입니다: %s", ErrInvalid, cursor)
+	}
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("%w: 잘못된 cursor입니다: %s", ErrInvalid, cursor)
+	}
+	return offset, nil
+}
+
+func (opts *PageOptions) validate() error {
+	if opts.Sort == "" {
+		opts.Sort = "name"
+	}
+	defaultOrder, ok := pageSortOrders[opts.Sort]
+	if !ok {
+		return fmt.Errorf("%w: sort는 name, rating, price, last_visit, distance 중 하나여야 합니다: %s", ErrInvalid, opts.Sort)
+	}
+	if opts.Order == "" {
+		opts.Order = defaultOrder
+	}
+	if opts.Order != "asc" && opts.Order != "desc" {
+		return fmt.Errorf("%w: order는 asc 또는 desc여야 합니다: %s", ErrInvalid, opts.Order)
+	}
+	if opts.Limit == 0 {
+		opts.Limit = defaultPageLimit
+	}
+	if opts.Limit < 0 || opts.Limit > maxPageLimit {
+		return fmt.Errorf("%w: limit은 1에서 %d 사이여야 합니다: %d", ErrInvalid, maxPageLimit, opts.Limit)
+	}
+	return nil
+}
+
+// 조건에 맞는 식당을 정렬해 한 쪽씩 반환함
+// 정렬 값이 없는 식당(가격이 없는 메뉴, 방문 기록이나 좌표가 없는 식당)은 순서와 관계없이 뒤에 온다.
+func (s *Service) Page(opts PageOptions) (*Page, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	offset, err := decodeCursor(opts.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	query, err := ParseQuery(opts.Query)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	var filter *filterMatcher
+	if opts.Filter != "" {
+		for i := range data.Search.Filters {
+			if data.Search.Filters[i].Name == opts.Filter {
+				if filter, err = data.Search.Filters[i].compile(); err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+		if filter == nil {
+			return nil, fmt.Errorf("%w: 검색 필터가 없습니다: %s", ErrInvalid, opts.Filter)
+		}
+	}
+	origin, err := data.CLIConfig.FindOrigin(opts.From)
+	if err != nil {
+		return nil, err
+	}
+	ctx := data.queryContext(s.now())
+
+	items := []Listing{}
+	for _, rest := range data.Restaurants {
+		if !query.Matches(ctx, &rest) || (filter != nil && !filter.matches(ctx, &rest)) {
+			continue
+		}
+		item := Listing{Restaurant: rest, Prices: rest.priceRange()}
+		if walk, ok := data.CLIConfig.WalkFrom(origin, &rest); ok {
+			item.Walk = &walk
+		}
+		items = append(items, item)
+	}
+	sortListings(items, opts.Sort, opts.Order == "desc", ctx)
+
+	page := &Page{Items: []Listing{}, Total: len(items)}
+	if offset < len(items) {
+		end := min(offset+opts.Limit, len(items))
+		page.Items = items[offset:end]
+		if end < len(items) {
+			page.NextCursor = encodeCursor(end)
+		}
+	}
+	return page, nil
+}
+
+// 정렬 기준의 값. 값이 없으면 false
+func sortKey(item *Listing, by string, ctx *queryContext) (float64, bool) {
+	switch by {
+	case "rating":
+		return item.Rating, true
+	case "price":
+		price, ok := item.cheapestMenuPrice()
+		return float64(price), ok
+	case "last_visit":
+		last, ok := ctx.last[item.Name]
+		return float64(last.Unix()), ok
+	case "distance":
+		if item.Walk == nil {
+			return 0, false
+		}
+		return item.Walk.Meters, true
+	}
+	return 0, false
+}
+
+// 같은 값이면 이름 순서로 정렬해 쪽을 나눠도 순서가 바뀌지 않게 함
+func sortListings(items []Listing, by string, desc bool, ctx *queryContext) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := &items[i], &items[j]
+		if by != "name" {
+			ka, okA := sortKey(a, by, ctx)
+			kb, okB := sortKey(b, by, ctx)
+			if okA != okB {
+				return okA
+			}
+			if ka != kb {
+				return (ka < kb) != desc
+			}
+		}
+		na, nb := strings.ToLower(a.Name), strings.ToLower(b.Name)
+		if by == "name" && desc {
+			return na > nb
+		}
+		return na < nb
+	})
+}
+
+// 이름으로 식당 하나를 찾음
+func (s *Service) Get(name string) (*Restaurant, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	return data.findRestaurant(name)
+}
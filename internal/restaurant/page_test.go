@@ -0,0 +1,105 @@
+package restaurant
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func pageTestData() *RestaurantData {
+	data := queryTestData()
+	data.Search.Filters = []SearchFilter{{Name: "일식", Categories: []string{"일식"}}}
+	return data
+}
+
+func listingNames(items []Listing) []string {
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func TestServicePage_Sort(t *testing.T) {
+	s := newTestService(t, pageTestData(), time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+	cases := []struct {
+		sort, order string
+		want        []string
+	}{
+		{"", "", []string{"국밥집", "김밥천국", "라멘집", "스시집"}},
+		{"name", "desc", []string{"스시집", "라멘집", "김밥천국", "국밥집"}},
+		{"rating", "", []string{"스시집", "국밥집", "라멘집", "김밥천국"}},
+		{"price", "asc", []string{"김밥천국", "국밥집", "라멘집", "스시집"}},
+		// 방문 기록이 없는 식당은 뒤에 이름 순서로 옴
+		{"last_visit", "", []string{"국밥집", "라멘집", "김밥천국", "스시집"}},
+		{"last_visit", "asc", []string{"라멘집", "국밥집", "김밥천국", "스시집"}},
+	}
+	for _, tc := range cases {
+		page, err := s.Page(PageOptions{Sort: tc.sort, Order: tc.order})
+		if err != nil {
+			t.Fatalf("%s %s: 조회 실패: %v", tc.sort, tc.order, err)
+		}
+		if got := listingNames(page.Items); !sameNames(got, tc.want) {
+			t.Errorf("%s %s: %q, 기대값 %q", tc.sort, tc.order, got, tc.want)
+		}
+	}
+}
+
+func TestServicePage_Cursor(t *testing.T) {
+	s := newTestService(t, pageTestData(), time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+
+	first, err := s.Page(PageOptions{Limit: 3})
+	if err != nil {
+		t.Fatalf("조회 실패: %v", err)
+	}
+	if first.Total != 4 || len(first.Items) != 3 || first.NextCursor == "" {
+		t.Fatalf("첫 쪽이 잘못됨: total %d, %d개, cursor %q", first.Total, len(first.Items), first.NextCursor)
+	}
+	second, err := s.Page(PageOptions{Limit: 3, Cursor: first.NextCursor})
+	if err != nil {
+		t.Fatalf("다음 쪽 조회 실패: %v", err)
+	}
+	if len(second.Items) != 1 || second.Items[0].Name != "스시집" || second.NextCursor != "" {
+		t.Fatalf("마지막 쪽이 잘못됨: %q, cursor %q", listingNames(second.Items), second.NextCursor)
+	}
+
+	if _, err := s.Page(PageOptions{Cursor: "!!"}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("잘못된 cursor에 ErrInvalid가 아님: %v", err)
+	}
+}
+
+func TestServicePage_Filter(t *testing.T) {
+	s := newTestService(t, pageTestData(), time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+
+	page, err := s.Page(PageOptions{Filter: "일식", Query: "rating>4"})
+	if err != nil {
+		t.Fatalf("조회 실패: %v", err)
+	}
+	if page.Total != 1 || page.Items[0].Name != "스시집" {
+		t.Fatalf("필터와 검색식이 함께 적용되지 않음: %q", listingNames(page.Items))
+	}
+
+	for _, opts := range []PageOptions{
+		{Filter: "없는 필터"},
+		{Sort: "color"},
+		{Order: "up"},
+		{Limit: 1000},
+		{Query: "rating>"},
+	} {
+		if _, err := s.Page(opts); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%+v: ErrInvalid가 아님: %v", opts, err)
+		}
+	}
+}
+
+func TestServiceGet(t *testing.T) {
+	s := newTestService(t, pageTestData(), time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+
+	item, err := s.Get("라멘집")
+	if err != nil || item.Name != "라멘집" {
+		t.Fatalf("식당 조회 실패: %v, %v", item, err)
+	}
+	if _, err := s.Get("없는 식당"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("없는 식당에 ErrNotFound가 아님: %v", err)
+	}
+}
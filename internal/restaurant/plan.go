@@ -0,0 +1,350 @@
+package restaurant
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/arch-spatula/jmc/internal/holiday"
+)
+
+// 요일별 점심 종류
+const (
+	// 평소처럼 추천받아 먹는 날
+	PlanNormal = ""
+	// 팀 점심. 식당은 정하지만 개인 예산에서 빠진다.
+	PlanTeam = "team"
+	// 점심을 거르거나 따로 먹는 날
+	PlanSkip = "skip"
+)
+
+var planKinds = []string{PlanNormal, PlanTeam, PlanSkip}
+
+var weekdayNames = []string{"일", "월", "화", "수", "목", "금", "토"}
+
+// 요일의 한 글자 이름. 예: 월
+func WeekdayName(wd time.Weekday) string {
+	return weekdayNames[wd]
+}
+
+// 월요일부터 금요일까지의 점심 계획
+type Plan struct {
+	// 계획한 주의 월요일
+	Week string `json:"week"`
+	Mode string `json:"mode"`
+	// 주간 예산 (원). 0이면 제한 없음
+	Budget int       `json:"budget"`
+	Days   []PlanDay `json:"days"`
+}
+
+type PlanDay struct {
+	Date       string `json:"date"`
+	Weekday    string `json:"weekday"`
+	Kind       string `json:"kind"`
+	Restaurant string `json:"restaurant"`
+	// 가장 싼 메뉴 기준의 예상 가격. 메뉴가 없으면 0
+	Price int    `json:"price"`
+	Note  string `json:"note"`
+}
+
+type PlanOptions struct {
+	// 계획할 주에 포함된 아무 날짜. 비어 있으면 이번 주
+	Week   string `json:"week"`
+	Mode   string `json:"mode"`
+	Budget int    `json:"budget"`
+	// 요일 이름(월~금) 또는 날짜 → 종류. 공휴일은 따로 정하지 않으면 건너뛴다.
+	Kinds map[string]string `json:"kinds"`
+	Seed  *int64            `json:"seed"`
+}
+
+func (p *Plan) Validate() error {
+	if _, err := time.Parse(dateLayout, p.Week); err != nil {
+		return fmt.Errorf("plan week는 YYYY-MM-DD 형식이어야 합니다")
+	}
+	if p.Budget < 0 {
+		return fmt.Errorf("plan budget은 0 이상이어야 합니다")
+	}
+	for _, day := range p.Days {
+		if !contains(planKinds, day.Kind) {
+			return fmt.Errorf("plan kind는 team, skip 또는 빈 문자열이어야 합니다: %s", day.Date)
+		}
+	}
+	return nil
+}
+
+// 주어진 날짜가 속한 주의 월요일
+func mondayOf(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 12, 0, 0, 0, time.Local)
+}
+
+// 요일 이름(월~금) 또는 날짜로 계획의 날을 찾음
+func (p *Plan) dayIndex(key string) (int, error) {
+	for i, day := range p.Days {
+		if day.Date == key || day.Weekday == key || day.Weekday+"요일" == key {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: 계획에 없는 날입니다: %s", ErrInvalid, key)
+}
+
+// 가장 싼 메뉴의 가격. 가격이 있는 메뉴가 없으면 false
+func (r *Restaurant) cheapestMenuPrice() (int, bool) {
+	cheapest, found := 0, false
+	for _, m := range r.Menus {
+		if m.Price > 0 && (!found || m.Price < cheapest) {
+			cheapest, found = m.Price, true
+		}
+	}
+	return cheapest, found
+}
+
+// except번째 날을 뺀 나머지 날 중 개인 예산에 들어가는 예상 지출 합계
+func (p *Plan) spent(except int) int {
+	total := 0
+	for i, day := range p.Days {
+		if i != except && day.Kind == PlanNormal {
+			total += day.Price
+		}
+	}
+	return total
+}
+
+// 계획의 i번째 날을 정함
+// 다른 날과 식당이 겹치지 않게 하고, 가능하면 카테고리도 겹치지 않게 한다.
+// 앞선 날의 계획은 방문한 것으로 보고 쿨다운과 다양성 점수에 반영한다.
+func planDay(data *RestaurantData, plan *Plan, i int, mode *Mode, r *rand.Rand) {
+	day := &plan.Days[i]
+	day.Restaurant, day.Price, day.Note = "", 0, ""
+	date, err := time.ParseInLocation(dateLayout, day.Date, time.Local)
+	if err != nil {
+		day.Note = "날짜 형식이 잘못되었습니다"
+		return
+	}
+	if h, ok := holiday.Lookup(date); ok {
+		day.Note = h.Name
+	}
+	if day.Kind == PlanSkip {
+		return
+	}
+	now := date.Add(12 * time.Hour)
+
+	sim := *data
+	sim.Visits = append([]Visit{}, data.Visits...)
+	planned := []string{}
+	plannedCategories := []string{}
+	for j, other := range plan.Days {
+		if j == i || other.Restaurant == "" {
+			continue
+		}
+		planned = append(planned, other.Restaurant)
+		if rest, err := data.findRestaurant(other.Restaurant); err == nil {
+			plannedCategories = append(plannedCategories, rest.Categories...)
+		}
+		if other.Date < day.Date {
+			sim.Visits = append(sim.Visits, Visit{Restaurant: other.Restaurant, Date: other.Date})
+		}
+	}
+
+	// 예산: 남은 평일을 가장 싼 식당으로 채워도 예산을 넘지 않는 식당만 고름
+	prices := data.priceIndex()
+	overBudget := []string{}
+	if plan.Budget > 0 && day.Kind == PlanNormal {
+		remainingDays := 0
+		for j := i + 1; j < len(plan.Days); j++ {
+			if plan.Days[j].Kind == PlanNormal && plan.Days[j].Restaurant == "" {
+				remainingDays++
+			}
+		}
+		cheapest := 0
+		for _, rest := range data.Restaurants {
+			if price, ok := prices.mealPrice(&rest); ok && !rest.Archived && (cheapest == 0 || price < cheapest) {
+				cheapest = price
+			}
+		}
+		left := plan.Budget - plan.spent(i) - cheapest*remainingDays
+		for _, rest := range data.Restaurants {
+			if price, ok := prices.mealPrice(&rest); ok && price > left {
+				overBudget = append(overBudget, rest.Name)
+			}
+		}
+	}
+
+	sameCategory := []string{}
+	for _, rest := range data.Restaurants {
+		if hasAny(rest.Categories, plannedCategories) {
+			sameCategory = append(sameCategory, rest.Name)
+		}
+	}
+
+	// 팀이 같이 먹으므로 모든 팀원의 식단 프로필을 지킴
+	diet := data.teamDiet()
+	exclude := append(append([]string{}, planned...), overBudget...)
+	picks := selectCandidates(&sim, mode, now, conditions{exclude: append(exclude, sameCategory...), diet: diet}, r, 1)
+	if len(picks) == 0 {
+		// 카테고리를 모두 나눌 수 없으면 카테고리 조건은 포기함
+		picks = selectCandidates(&sim, mode, now, conditions{exclude: exclude, diet: diet}, r, 1)
+	}
+	if len(picks) == 0 {
+		day.Note = "조건에 맞는 식당이 없습니다"
+		return
+	}
+	day.Restaurant = picks[0].Name
+	day.Price, _ = prices.mealPrice(&picks[0].Restaurant)
+}
+
+// 한 주의 점심 계획을 새로 만들어 저장함
+func (s *Service) NewPlan(opts PlanOptions) (*Plan, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	mode, err := data.CLIConfig.FindMode(opts.Mode)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Budget < 0 {
+		return nil, fmt.Errorf("%w: 예산은 0 이상이어야 합니다", ErrInvalid)
+	}
+
+	base := s.now()
+	if opts.Week != "" {
+		base, err = time.ParseInLocation(dateLayout, opts.Week, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("%w: 주는 YYYY-MM-DD 형식이어야 합니다: %s", ErrInvalid, opts.Week)
+		}
+	}
+	monday := mondayOf(base)
+
+	plan := &Plan{Week: monday.Format(dateLayout), Mode: mode.Name, Budget: opts.Budget}
+	for i := 0; i < 5; i++ {
+		date := monday.AddDate(0, 0, i)
+		day := PlanDay{
+			Date:    date.Format(dateLayout),
+			Weekday: WeekdayName(date.Weekday()),
+		}
+		// 공휴일에는 출근하지 않으므로 기본으로 건너뜀
+		if holiday.IsHoliday(date) {
+			day.Kind = PlanSkip
+		}
+		plan.Days = append(plan.Days, day)
+	}
+	// 맵 순서에 따라 결과나 오류가 달라지지 않도록 키를 정렬해 적용하고,
+	// "월"과 "2026-10-19"처럼 같은 날을 가리키는 키는 함께 쓸 수 없다.
+	keys := make([]string, 0, len(opts.Kinds))
+	for key := range opts.Kinds {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	seen := make(map[int]string, len(keys))
+	for _, key := range keys {
+		kind := opts.Kinds[key]
+		if !contains(planKinds, kind) {
+			return nil, fmt.Errorf("%w: 알 수 없는 종류입니다: %s", ErrInvalid, kind)
+		}
+		i, err := plan.dayIndex(key)
+		if err != nil {
+			return nil, err
+		}
+		if prev, ok := seen[i]; ok {
+			return nil, fmt.Errorf("%w: 같은 날을 두 번 지정했습니다: %s, %s", ErrInvalid, prev, key)
+		}
+		seen[i] = key
+		plan.Days[i].Kind = kind
+	}
+
+	r := s.planRand(opts.Seed)
+	for i := range plan.Days {
+		planDay(data, plan, i, mode, r)
+	}
+
+	data.Plan = plan
+	if err := s.repo.Save(data); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (s *Service) planRand(seed *int64) *rand.Rand {
+	if seed != nil {
+		return rand.New(rand.NewSource(*seed))
+	}
+	return rand.New(rand.NewSource(s.now().UnixNano()))
+}
+
+func (s *Service) GetPlan() (*Plan, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	return data.Plan, nil
+}
+
+// 계획의 하루를 다시 뽑음. kind가 nil이 아니면 종류도 바꾼다.
+// day는 요일 이름(월~금) 또는 날짜다.
+func (s *Service) RerollPlanDay(day string, kind *string) (*Plan, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	if data.Plan == nil {
+		return nil, fmt.Errorf("%w: 점심 계획이 없습니다", ErrInvalid)
+	}
+	i, err := data.Plan.dayIndex(day)
+	if err != nil {
+		return nil, err
+	}
+	if kind != nil {
+		if !contains(planKinds, *kind) {
+			return nil, fmt.Errorf("%w: 알 수 없는 종류입니다: %s", ErrInvalid, *kind)
+		}
+		data.Plan.Days[i].Kind = *kind
+	}
+	mode, err := data.CLIConfig.FindMode(data.Plan.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	planDay(data, data.Plan, i, mode, s.planRand(nil))
+	if err := s.repo.Save(data); err != nil {
+		return nil, err
+	}
+	return data.Plan, nil
+}
+
+// 계획을 iCalendar(ICS) 형식으로 만듦. 점심은 12시부터 1시간으로 잡는다.
+func (p *Plan) ICS(now time.Time) string {
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\n")
+	b.WriteString("VERSION:2.0\r\n")
+	b.WriteString("PRODID:-//jmc//lunch plan//KO\r\n")
+	stamp := now.UTC().Format("20060102T150405Z")
+	for _, day := range p.Days {
+		if day.Restaurant == "" {
+			continue
+		}
+		date, err := time.Parse(dateLayout, day.Date)
+		if err != nil {
+			continue
+		}
+		summary := "점심: " + day.Restaurant
+		if day.Kind == PlanTeam {
+			summary = "팀 점심: " + day.Restaurant
+		}
+		b.WriteString("BEGIN:VEVENT\r\n")
+		b.WriteString("UID:" + day.Date + "@jmc\r\n")
+		b.WriteString("DTSTAMP:" + stamp + "\r\n")
+		b.WriteString("DTSTART:" + date.Format("20060102") + "T120000\r\n")
+		b.WriteString("DTEND:" + date.Format("20060102") + "T130000\r\n")
+		b.WriteString("SUMMARY:" + escapeICS(summary) + "\r\n")
+		b.WriteString("END:VEVENT\r\n")
+	}
+	b.WriteString("END:VCALENDAR\r\n")
+	return b.String()
+}
+
+func escapeICS(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
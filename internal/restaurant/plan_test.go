@@ -0,0 +1,143 @@
+package restaurant
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func planRestaurants() []Restaurant {
+	list := testRestaurants("국밥", "파스타", "초밥", "쌀국수", "돈까스", "버거")
+	categories := [][]string{{"한식"}, {"양식"}, {"일식"}, {"아시안"}, {"일식"}, {"양식"}}
+	prices := []int{9000, 15000, 20000, 11000, 10000, 8000}
+	for i := range list {
+		list[i].Categories = categories[i]
+		list[i].Menus = []Menu{{Name: "대표", Price: prices[i]}}
+	}
+	return list
+}
+
+func TestServiceNewPlan_NoRepeatsAndKinds(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: planRestaurants()}, time.Date(2026, 3, 11, 9, 0, 0, 0, time.Local))
+	seed := int64(7)
+
+	plan, err := s.NewPlan(PlanOptions{
+		Kinds: map[string]string{"수": PlanTeam, "금": PlanSkip},
+		Seed:  &seed,
+	})
+	if err != nil {
+		t.Fatalf("계획 생성 실패: %v", err)
+	}
+	if plan.Week != "2026-03-09" || len(plan.Days) != 5 {
+		t.Fatalf("2026-03-09 주의 5일을 기대했지만 %s, %d일", plan.Week, len(plan.Days))
+	}
+	if plan.Days[2].Kind != PlanTeam || plan.Days[4].Kind != PlanSkip {
+		t.Fatalf("수요일 팀 점심, 금요일 건너뜀을 기대했지만 %+v", plan.Days)
+	}
+	if plan.Days[4].Restaurant != "" {
+		t.Fatalf("건너뛰는 날에 식당이 정해짐: %s", plan.Days[4].Restaurant)
+	}
+
+	seen := map[string]bool{}
+	for _, day := range plan.Days[:4] {
+		if day.Restaurant == "" {
+			t.Fatalf("%s에 식당이 정해지지 않음: %s", day.Weekday, day.Note)
+		}
+		if seen[day.Restaurant] {
+			t.Fatalf("같은 식당이 반복됨: %s", day.Restaurant)
+		}
+		seen[day.Restaurant] = true
+	}
+
+	saved, err := s.GetPlan()
+	if err != nil || saved == nil || saved.Week != plan.Week {
+		t.Fatalf("계획이 저장되지 않음: %v, %v", saved, err)
+	}
+}
+
+func TestServiceNewPlan_Budget(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: planRestaurants()}, time.Date(2026, 3, 9, 9, 0, 0, 0, time.Local))
+	seed := int64(1)
+
+	plan, err := s.NewPlan(PlanOptions{Budget: 50000, Seed: &seed})
+	if err != nil {
+		t.Fatalf("계획 생성 실패: %v", err)
+	}
+	if total := plan.spent(-1); total > 50000 {
+		t.Fatalf("예산 50,000원을 넘음: %d", total)
+	}
+}
+
+func TestServiceRerollPlanDay_ChangesKind(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: planRestaurants()}, time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local))
+	if _, err := s.NewPlan(PlanOptions{}); err != nil {
+		t.Fatalf("계획 생성 실패: %v", err)
+	}
+
+	skip := PlanSkip
+	plan, err := s.RerollPlanDay("2026-03-03", &skip)
+	if err != nil {
+		t.Fatalf("다시 뽑기 실패: %v", err)
+	}
+	if plan.Days[1].Kind != PlanSkip || plan.Days[1].Restaurant != "" {
+		t.Fatalf("화요일이 건너뜀으로 바뀌지 않음: %+v", plan.Days[1])
+	}
+	if _, err := s.RerollPlanDay("토", nil); err == nil {
+		t.Fatal("계획에 없는 요일인데 에러가 발생하지 않음")
+	}
+}
+
+func TestServiceNewPlan_SkipsPublicHolidays(t *testing.T) {
+	// 2026-03-02는 삼일절 대체공휴일
+	s := newTestService(t, &RestaurantData{Restaurants: planRestaurants()}, time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local))
+
+	plan, err := s.NewPlan(PlanOptions{})
+	if err != nil {
+		t.Fatalf("계획 생성 실패: %v", err)
+	}
+	if plan.Days[0].Kind != PlanSkip || plan.Days[0].Note != "삼일절 대체공휴일" {
+		t.Fatalf("공휴일은 건너뛰어야 하지만 %+v", plan.Days[0])
+	}
+
+	// 직접 정하면 공휴일에도 점심을 계획함
+	plan, err = s.NewPlan(PlanOptions{Kinds: map[string]string{"월": PlanTeam}})
+	if err != nil {
+		t.Fatalf("계획 생성 실패: %v", err)
+	}
+	if plan.Days[0].Kind != PlanTeam || plan.Days[0].Restaurant == "" {
+		t.Fatalf("월요일 팀 점심을 기대했지만 %+v", plan.Days[0])
+	}
+
+	// 요일과 날짜로 같은 날을 두 번 정하면 어느 쪽이 적용될지 정해지지 않으므로 거부함
+	_, err = s.NewPlan(PlanOptions{Kinds: map[string]string{"월": PlanTeam, "2026-03-02": PlanSkip}})
+	if !errors.Is(err, ErrInvalid) {
+		t.Fatalf("같은 날을 두 번 정하면 ErrInvalid를 기대했지만 %v", err)
+	}
+}
+
+func TestPlanICS(t *testing.T) {
+	plan := Plan{Week: "2026-03-02", Days: []PlanDay{
+		{Date: "2026-03-02", Weekday: "월", Restaurant: "국밥, 순대"},
+		{Date: "2026-03-03", Weekday: "화", Kind: PlanSkip},
+	}}
+	ics := plan.ICS(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	if !strings.Contains(ics, "DTSTART:20260302T120000\r\n") {
+		t.Fatalf("점심 시작 시각이 없음:\n%s", ics)
+	}
+	if !strings.Contains(ics, `SUMMARY:점심: 국밥\, 순대`) {
+		t.Fatalf("SUMMARY가 이스케이프되지 않음:\n%s", ics)
+	}
+	if strings.Count(ics, "BEGIN:VEVENT") != 1 {
+		t.Fatalf("건너뛰는 날은 일정에서 빠져야 함:\n%s", ics)
+	}
+}
+
+func TestFormatPrice(t *testing.T) {
+	cases := map[int]string{0: "0", 900: "900", 12000: "12,000", 1234567: "1,234,567", -4500: "-4,500"}
+	for n, want := range cases {
+		if got := FormatPrice(n); got != want {
+			t.Fatalf("FormatPrice(%d) = %s, 기대값 %s", n, got, want)
+		}
+	}
+}
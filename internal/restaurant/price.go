@@ -0,0 +1,133 @@
+package restaurant
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// 금액을 회계 단위로 표시함 (예: 12,000)
+func FormatPrice(n int) string {
+	s := strconv.Itoa(n)
+	sign := ""
+	if n < 0 {
+		sign, s = "-", s[1:]
+	}
+	out := make([]byte, 0, len(s)+len(s)/3)
+	for i := 0; i < len(s); i++ {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			out = append(out, ',')
+		}
+		out = append(out, s[i])
+	}
+	return sign + string(out)
+}
+
+// 메뉴 가격을 모르는 식당을 예산 조건에서 다루는 방법
+const (
+	// 예산과 관계없이 추천함 (기본값)
+	NoMenuInclude = "include"
+	// 예산이 있으면 추천하지 않음
+	NoMenuExclude = "exclude"
+	// cli_config.category_prices의 카테고리별 가격으로 봄. 가격이 정해진 카테고리가 없으면 추천함
+	NoMenuCategory = "category"
+)
+
+// 메뉴 가격 범위
+type PriceRange struct {
+	Min    int `json:"min"`
+	Median int `json:"median"`
+	Max    int `json:"max"`
+}
+
+func (p PriceRange) String() string {
+	if p.Min == p.Max {
+		return FormatPrice(p.Min) + "원"
+	}
+	return fmt.Sprintf("%s~%s원 (중간 %s원)", FormatPrice(p.Min), FormatPrice(p.Max), FormatPrice(p.Median))
+}
+
+// 가격이 있는 메뉴의 가격 범위. 가격이 있는 메뉴가 없으면 nil
+// 메뉴 수가 짝수면 가운데 두 가격의 평균을 중간값으로 쓴다.
+func (r *Restaurant) priceRange() *PriceRange {
+	prices := []int{}
+	for _, m := range r.Menus {
+		if m.Price > 0 {
+			prices = append(prices, m.Price)
+		}
+	}
+	if len(prices) == 0 {
+		return nil
+	}
+	sort.Ints(prices)
+	n := len(prices)
+	median := prices[n/2]
+	if n%2 == 0 {
+		median = (prices[n/2-1] + prices[n/2]) / 2
+	}
+	return &PriceRange{Min: prices[0], Median: median, Max: prices[n-1]}
+}
+
+// 한 끼 가격을 계산할 때 쓰는 카테고리 색인과 카테고리별 가격
+// 식당마다 다시 만들지 않도록 요청마다 한 번 만들어 넘긴다.
+type priceIndex struct {
+	policy     string
+	categories termIndex
+	// 정규화한 카테고리 이름별 가격
+	byCategory map[string]int
+}
+
+func (d *RestaurantData) priceIndex() *priceIndex {
+	return d.priceIndexWith(d.categoryIndex())
+}
+
+// 이미 만든 카테고리 색인으로 만듦
+func (d *RestaurantData) priceIndexWith(categories termIndex) *priceIndex {
+	byCategory := map[string]int{}
+	for category, price := range d.CLIConfig.CategoryPrices {
+		// 가격을 모르는 0원은 검증을 거치지 않은 데이터에서만 들어오므로 없는 가격으로 본다.
+		if price > 0 {
+			byCategory[termKey(category)] = price
+		}
+	}
+	return &priceIndex{policy: d.CLIConfig.NoMenuPolicy, categories: categories, byCategory: byCategory}
+}
+
+// 식당에서 한 끼를 먹는 가장 싼 가격
+// 가격이 있는 메뉴가 없으면 no_menu_policy가 category일 때 카테고리별 가격(하위 카테고리는 상위 카테고리의 가격)을 쓴다.
+func (p *priceIndex) mealPrice(r *Restaurant) (int, bool) {
+	if price, ok := r.cheapestMenuPrice(); ok {
+		return price, true
+	}
+	if p.policy != NoMenuCategory {
+		return 0, false
+	}
+	cheapest, found := 0, false
+	for _, c := range r.Categories {
+		path, ok := p.categories[termKey(c)]
+		if !ok {
+			continue
+		}
+		for i := len(path) - 1; i >= 0; i-- {
+			if price, ok := p.byCategory[termKey(path[i])]; ok {
+				if !found || price < cheapest {
+					cheapest, found = price, true
+				}
+				break
+			}
+		}
+	}
+	return cheapest, found
+}
+
+// 예산 안에서 먹을 수 있는 식당인지 확인함. budget이 0 이하면 항상 true
+func (p *priceIndex) withinBudget(r *Restaurant, budget int) bool {
+	if budget <= 0 {
+		return true
+	}
+	price, ok := p.mealPrice(r)
+	if !ok {
+		return p.policy != NoMenuExclude
+	}
+	return price <= budget
+}
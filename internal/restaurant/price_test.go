@@ -0,0 +1,86 @@
+package restaurant
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRestaurantPriceRange(t *testing.T) {
+	r := testRestaurants("국밥집")[0]
+	if r.priceRange() != nil {
+		t.Fatal("메뉴가 없는데 가격 범위가 있음")
+	}
+
+	r.Menus = []Menu{{Name: "a", Price: 12000}, {Name: "b", Price: 8000}, {Name: "c"}, {Name: "d", Price: 9000}}
+	if got := *r.priceRange(); got != (PriceRange{Min: 8000, Median: 9000, Max: 12000}) {
+		t.Fatalf("가격 범위 %+v", got)
+	}
+	r.Menus = append(r.Menus, Menu{Name: "e", Price: 20000})
+	if got := r.priceRange().Median; got != 10500 {
+		t.Fatalf("짝수 개 메뉴의 중간값 %d, 기대값 10500", got)
+	}
+}
+
+func budgetTestData(policy string) *RestaurantData {
+	list := testRestaurants("국밥집", "스시집", "라멘집", "분식집")
+	list[0].Menus = []Menu{{Name: "순대국", Price: 9000}, {Name: "수육", Price: 25000}}
+	list[1].Menus = []Menu{{Name: "모둠초밥", Price: 18000}}
+	list[2].Categories = []string{"라멘"}
+	list[3].Categories = []string{"분식"}
+	return &RestaurantData{
+		Restaurants: list,
+		Categories:  []Category{{Name: "일식", Children: []Category{{Name: "라멘"}}}},
+		CLIConfig: CLIConfig{
+			NoMenuPolicy:   policy,
+			CategoryPrices: map[string]int{"일식": 13000},
+		},
+	}
+}
+
+func TestWithinBudget_Policies(t *testing.T) {
+	cases := []struct {
+		policy string
+		want   []string
+	}{
+		{"", []string{"국밥집", "라멘집", "분식집"}},
+		{NoMenuExclude, []string{"국밥집"}},
+		// 라멘은 상위 카테고리인 일식의 가격으로 봄. 분식은 정한 가격이 없어 포함함
+		{NoMenuCategory, []string{"국밥집", "분식집"}},
+	}
+	for _, tc := range cases {
+		data := budgetTestData(tc.policy)
+		prices := data.priceIndex()
+		got := []string{}
+		for i := range data.Restaurants {
+			if prices.withinBudget(&data.Restaurants[i], 12000) {
+				got = append(got, data.Restaurants[i].Name)
+			}
+		}
+		if !sameNames(got, tc.want) {
+			t.Errorf("%q: %q, 기대값 %q", tc.policy, got, tc.want)
+		}
+	}
+}
+
+func TestServiceRecommend_Budget(t *testing.T) {
+	s := newTestService(t, budgetTestData(NoMenuExclude), time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+
+	picks, err := s.Recommend(RecommendOptions{Count: 4, Budget: 12000})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	if len(picks) != 1 || picks[0].Name != "국밥집" {
+		t.Fatalf("예산을 넘는 식당이 추천됨: %q", namesOf(picks))
+	}
+	if picks[0].Order == nil || picks[0].Order.Name != "순대국" {
+		t.Fatalf("예산 안의 메뉴가 추천되지 않음: %+v", picks[0].Order)
+	}
+	if picks[0].Prices == nil || picks[0].Prices.Max != 25000 {
+		t.Fatalf("가격 범위가 없음: %+v", picks[0].Prices)
+	}
+
+	if _, err := s.Recommend(RecommendOptions{Budget: -1}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("음수 예산에 ErrInvalid가 아님: %v", err)
+	}
+}
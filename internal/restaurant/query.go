@@ -0,0 +1,553 @@
+package restaurant
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// 식당을 거르는 검색식
+//
+//	rating>=4 category:한식 -visited
+//	(category:일식 OR category:중식) price<10000 last>14d
+//
+// 낱말을 나란히 쓰면 AND, OR(또는 |)로 잇거나 -, NOT으로 뒤집을 수 있고 괄호로 묶을 수 있다.
+// 필드는 rating, price(가장 싼 메뉴), category, location, visited, last(마지막 방문 후 일 수), name, menu이고
+// 필드 없이 쓴 낱말은 이름이나 메뉴에 들어 있는지 본다.
+type Query struct {
+	source string
+	root   queryNode
+}
+
+func (q *Query) String() string {
+	return q.source
+}
+
+// 검색식 문법 오류. 몇 번째 글자에서 틀렸는지 알려준다.
+type QueryError struct {
+	Query string
+	// 0부터 센 글자 위치
+	Pos int
+	Msg string
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("검색식 오류: %s (%d번째 글자)", e.Msg, e.Pos+1)
+}
+
+func (e *QueryError) Unwrap() error {
+	return ErrInvalid
+}
+
+// 검색식과 틀린 위치를 가리키는 표시를 두 줄로 만듦
+func (e *QueryError) Caret() string {
+	runes := []rune(e.Query)
+	width := 0
+	for _, r := range runes[:min(e.Pos, len(runes))] {
+		// 한글 같은 전각 문자는 두 칸을 차지함
+		if r > unicode.MaxLatin1 {
+			width += 2
+		} else {
+			width++
+		}
+	}
+	return e.Query + "\n" + strings.Repeat(" ", width) + "^"
+}
+
+// 검색식을 평가할 때 필요한 데이터
+type queryContext struct {
+	now        time.Time
+	last       map[string]time.Time
+	regions    termIndex
+	categories termIndex
+	prices     *priceIndex
+}
+
+func (d *RestaurantData) queryContext(now time.Time) *queryContext {
+	categories := d.categoryIndex()
+	return &queryContext{
+		now:        now,
+		last:       d.lastVisits(),
+		regions:    buildTermIndex(d.Regions),
+		categories: categories,
+		prices:     d.priceIndexWith(categories),
+	}
+}
+
+func (q *Query) Matches(ctx *queryContext, r *Restaurant) bool {
+	if q == nil || q.root == nil {
+		return true
+	}
+	return q.root.eval(ctx, r)
+}
+
+type queryNode interface {
+	eval(ctx *queryContext, r *Restaurant) bool
+}
+
+type andNode []queryNode
+
+func (n andNode) eval(ctx *queryContext, r *Restaurant) bool {
+	for _, child := range n {
+		if !child.eval(ctx, r) {
+			return false
+		}
+	}
+	return true
+}
+
+type orNode []queryNode
+
+func (n orNode) eval(ctx *queryContext, r *Restaurant) bool {
+	for _, child := range n {
+		if child.eval(ctx, r) {
+			return true
+		}
+	}
+	return false
+}
+
+type notNode struct {
+	child queryNode
+}
+
+func (n notNode) eval(ctx *queryContext, r *Restaurant) bool {
+	return !n.child.eval(ctx, r)
+}
+
+// 필드 하나에 대한 조건
+type predicate func(ctx *queryContext, r *Restaurant) bool
+
+func (p predicate) eval(ctx *queryContext, r *Restaurant) bool {
+	return p(ctx, r)
+}
+
+// 한글 필드 이름
+var queryFieldAliases = map[string]string{
+	"평점":   "rating",
+	"가격":   "price",
+	"카테고리": "category",
+	"cat":  "category",
+	"위치":   "location",
+	"loc":  "location",
+	"방문":   "visited",
+	"마지막":  "last",
+	"이름":   "name",
+	"메뉴":   "menu",
+}
+
+var queryOperators = []string{">=", "<=", "!=", ">", "<", "=", ":"}
+
+type queryToken struct {
+	text string
+	pos  int
+	// 따옴표로 감싼 낱말은 연산자나 키워드로 보지 않음
+	quoted bool
+}
+
+// 공백과 괄호로 낱말을 나눔. 따옴표 안의 공백은 낱말에 포함한다.
+func tokenizeQuery(s string) ([]queryToken, error) {
+	runes := []rune(s)
+	tokens := []queryToken{}
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')':
+			tokens = append(tokens, queryToken{text: string(r), pos: i})
+			i++
+		default:
+			start := i
+			var b strings.Builder
+			quoted := false
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != '(' && runes[i] != ')' {
+				if runes[i] == '"' {
+					end := i + 1
+					for end < len(runes) && runes[end] != '"' {
+						end++
+					}
+					if end == len(runes) {
+						return nil, &QueryError{Query: s, Pos: i, Msg: "따옴표가 닫히지 않았습니다"}
+					}
+					b.WriteString(string(runes[i+1 : end]))
+					// name:"라멘 집"처럼 값만 감싼 낱말은 필드 조건으로 본다.
+					quoted = quoted || i == start
+					i = end + 1
+					continue
+				}
+				b.WriteRune(runes[i])
+				i++
+			}
+			tokens = append(tokens, queryToken{text: b.String(), pos: start, quoted: quoted})
+		}
+	}
+	return tokens, nil
+}
+
+type queryParser struct {
+	source string
+	tokens []queryToken
+	i      int
+}
+
+// 검색식을 해석함. 빈 문자열이면 모든 식당에 맞는 검색식이다.
+func ParseQuery(s string) (*Query, error) {
+	tokens, err := tokenizeQuery(s)
+	if err != nil {
+		return nil, err
+	}
+	q := &Query{source: s}
+	if len(tokens) == 0 {
+		return q, nil
+	}
+	p := &queryParser{source: s, tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.i < len(p.tokens) {
+		return nil, p.errorf(p.tokens[p.i].pos, "짝이 맞지 않는 닫는 괄호입니다")
+	}
+	q.root = root
+	return q, nil
+}
+
+func (p *queryParser) errorf(pos int, format string, args ...any) *QueryError {
+	return &QueryError{Query: p.source, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *queryParser) peek() (queryToken, bool) {
+	if p.i >= len(p.tokens) {
+		return queryToken{}, false
+	}
+	return p.tokens[p.i], true
+}
+
+// 다음 낱말의 다음 낱말
+func (p *queryParser) peek2() (queryToken, bool) {
+	if p.i+1 >= len(p.tokens) {
+		return queryToken{}, false
+	}
+	return p.tokens[p.i+1], true
+}
+
+// 따옴표 없이 쓴 키워드인지 확인함
+func (t queryToken) is(keywords ...string) bool {
+	if t.quoted {
+		return false
+	}
+	for _, k := range keywords {
+		if t.text == k {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *queryParser) parseOr() (queryNode, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	nodes := orNode{first}
+	for {
+		t, ok := p.peek()
+		if !ok || !t.is("OR", "or", "|") {
+			break
+		}
+		p.i++
+		next, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, next)
+	}
+	if len(nodes) == 1 {
+		return first, nil
+	}
+	return nodes, nil
+}
+
+func (p *queryParser) parseAnd() (queryNode, error) {
+	nodes := andNode{}
+	for {
+		t, ok := p.peek()
+		if !ok || t.is(")", "OR", "or", "|") {
+			break
+		}
+		if t.is("AND", "and", "&") {
+			p.i++
+			continue
+		}
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	if len(nodes) == 0 {
+		pos := len([]rune(p.source))
+		if t, ok := p.peek(); ok {
+			pos = t.pos
+		}
+		return nil, p.errorf(pos, "조건이 필요합니다")
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return nodes, nil
+}
+
+func (p *queryParser) parseUnary() (queryNode, error) {
+	t, ok := p.peek()
+	if !ok {
+		return nil, p.errorf(len([]rune(p.source)), "조건이 필요합니다")
+	}
+	if t.is(")") {
+		return nil, p.errorf(t.pos, "짝이 맞지 않는 닫는 괄호입니다")
+	}
+	// 따로 떨어진 -, !는 -(category:일식)처럼 괄호 앞에서만 NOT으로 본다.
+	if t.is("-", "!") {
+		if next, ok := p.peek2(); !ok || !next.is("(") {
+			return nil, p.errorf(t.pos, "%s는 조건에 붙여 써야 합니다 (예: -visited, -(category:일식))", t.text)
+		}
+	}
+	if t.is("NOT", "not", "-", "!") {
+		p.i++
+		if _, ok := p.peek(); !ok {
+			return nil, p.errorf(t.pos, "%s 뒤에 조건이 필요합니다", t.text)
+		}
+		child, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{child}, nil
+	}
+	if t.is("(") {
+		p.i++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		closing, ok := p.peek()
+		if !ok || !closing.is(")") {
+			return nil, p.errorf(t.pos, "괄호가 닫히지 않았습니다")
+		}
+		p.i++
+		return node, nil
+	}
+	p.i++
+	if !t.quoted && len(t.text) > 1 && (t.text[0] == '-' || t.text[0] == '!') {
+		child, err := p.parseTerm(queryToken{text: t.text[1:], pos: t.pos + 1})
+		if err != nil {
+			return nil, err
+		}
+		return notNode{child}, nil
+	}
+	return p.parseTerm(t)
+}
+
+// field연산자value 형태의 낱말 하나를 해석함
+func (p *queryParser) parseTerm(t queryToken) (queryNode, error) {
+	if t.quoted {
+		return textPredicate(t.text), nil
+	}
+	field, op, value, opPos := "", "", "", -1
+	for i, r := range t.text {
+		for _, candidate := range queryOperators {
+			if strings.HasPrefix(t.text[i:], candidate) {
+				field, op, value = t.text[:i], candidate, t.text[i+len(candidate):]
+				opPos = t.pos + len([]rune(t.text[:i]))
+				break
+			}
+		}
+		if op != "" || !(unicode.IsLetter(r) || r == '_') {
+			break
+		}
+	}
+	if op == "" {
+		if name := canonicalField(t.text); name == "visited" {
+			return visitedPredicate(true), nil
+		}
+		return textPredicate(t.text), nil
+	}
+	if field == "" {
+		return nil, p.errorf(t.pos, "%s 앞에 필드 이름이 필요합니다", op)
+	}
+	valuePos := opPos + len([]rune(op))
+	if value == "" {
+		return nil, p.errorf(valuePos, "%s%s 뒤에 값이 필요합니다", field, op)
+	}
+
+	name := canonicalField(field)
+	switch name {
+	case "rating":
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, p.errorf(valuePos, "rating은 숫자여야 합니다: %s", value)
+		}
+		cmp, err := p.comparison(op, opPos, name)
+		if err != nil {
+			return nil, err
+		}
+		return predicate(func(_ *queryContext, r *Restaurant) bool { return cmp(r.Rating, n) }), nil
+	case "price":
+		n, err := parseQueryPrice(value)
+		if err != nil {
+			return nil, p.errorf(valuePos, "price는 9000, 9,000원, 1.2만 같은 금액이어야 합니다: %s", value)
+		}
+		cmp, err := p.comparison(op, opPos, name)
+		if err != nil {
+			return nil, err
+		}
+		return predicate(func(_ *queryContext, r *Restaurant) bool {
+			price, ok := r.cheapestMenuPrice()
+			return ok && cmp(float64(price), n)
+		}), nil
+	case "last":
+		days, err := parseQueryDays(value)
+		if err != nil {
+			return nil, p.errorf(valuePos, "last는 14, 14d, 2w 같은 일 수여야 합니다: %s", value)
+		}
+		cmp, err := p.comparison(op, opPos, name)
+		if err != nil {
+			return nil, err
+		}
+		return predicate(func(ctx *queryContext, r *Restaurant) bool {
+			last, ok := ctx.last[r.Name]
+			if !ok {
+				// 가본 적 없는 식당은 아주 오래전에 간 것으로 봄
+				return op == ">" || op == ">=" || op == "!="
+			}
+			return cmp(float64(daysBetween(last, ctx.now)), days)
+		}), nil
+	case "visited":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, p.errorf(valuePos, "visited는 true 또는 false여야 합니다: %s", value)
+		}
+		return p.equality(op, opPos, name, visitedPredicate(b))
+	case "category":
+		return p.equality(op, opPos, name, predicate(func(ctx *queryContext, r *Restaurant) bool {
+			return ctx.categories.matchesAny(r.Categories, []string{value})
+		}))
+	case "location":
+		return p.equality(op, opPos, name, predicate(func(ctx *queryContext, r *Restaurant) bool {
+			return ctx.regions.matchesAny(r.Locations, []string{value})
+		}))
+	case "name":
+		return p.equality(op, opPos, name, predicate(func(_ *queryContext, r *Restaurant) bool {
+			return containsFold(r.Name, value)
+		}))
+	case "menu":
+		return p.equality(op, opPos, name, predicate(func(_ *queryContext, r *Restaurant) bool {
+			for _, m := range r.Menus {
+				if containsFold(m.Name, value) {
+					return true
+				}
+			}
+			return false
+		}))
+	}
+	return nil, p.errorf(t.pos, "알 수 없는 필드입니다: %s (rating, price, category, location, visited, last, name, menu)", field)
+}
+
+func canonicalField(s string) string {
+	s = strings.ToLower(s)
+	if name, ok := queryFieldAliases[s]; ok {
+		return name
+	}
+	return s
+}
+
+// 숫자 필드의 비교 연산
+func (p *queryParser) comparison(op string, pos int, field string) (func(a, b float64) bool, error) {
+	switch op {
+	case ">=":
+		return func(a, b float64) bool { return a >= b }, nil
+	case "<=":
+		return func(a, b float64) bool { return a <= b }, nil
+	case ">":
+		return func(a, b float64) bool { return a > b }, nil
+	case "<":
+		return func(a, b float64) bool { return a < b }, nil
+	case "=", ":":
+		return func(a, b float64) bool { return a == b }, nil
+	case "!=":
+		return func(a, b float64) bool { return a != b }, nil
+	}
+	return nil, p.errorf(pos, "%s에는 %s를 쓸 수 없습니다", field, op)
+}
+
+// 문자열 필드는 :, =, != 만 쓸 수 있음
+func (p *queryParser) equality(op string, pos int, field string, node queryNode) (queryNode, error) {
+	switch op {
+	case ":", "=":
+		return node, nil
+	case "!=":
+		return notNode{node}, nil
+	}
+	return nil, p.errorf(pos, "%s에는 :, =, != 만 쓸 수 있습니다", field)
+}
+
+func visitedPredicate(want bool) predicate {
+	return func(ctx *queryContext, r *Restaurant) bool {
+		_, recorded := ctx.last[r.Name]
+		return (r.Visited || recorded) == want
+	}
+}
+
+// 필드 없이 쓴 낱말은 이름이나 메뉴에서 찾음
+func textPredicate(text string) predicate {
+	return func(_ *queryContext, r *Restaurant) bool {
+		if containsFold(r.Name, text) {
+			return true
+		}
+		for _, m := range r.Menus {
+			if containsFold(m.Name, text) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func containsFold(s, sub string) bool {
+	return strings.Contains(strings.ToLower(cleanTerm(s)), strings.ToLower(cleanTerm(sub)))
+}
+
+// "9000", "9,000원", "1.2만", "9천"
+func parseQueryPrice(s string) (float64, error) {
+	s = strings.TrimSuffix(strings.ReplaceAll(s, ",", ""), "원")
+	unit := 1.0
+	switch {
+	case strings.HasSuffix(s, "만"):
+		s, unit = strings.TrimSuffix(s, "만"), 10000
+	case strings.HasSuffix(s, "천"):
+		s, unit = strings.TrimSuffix(s, "천"), 1000
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	return n * unit, nil
+}
+
+// "14", "14d", "2w"
+func parseQueryDays(s string) (float64, error) {
+	unit := 1.0
+	switch {
+	case strings.HasSuffix(s, "d"), strings.HasSuffix(s, "일"):
+		s = strings.TrimSuffix(strings.TrimSuffix(s, "d"), "일")
+	case strings.HasSuffix(s, "w"), strings.HasSuffix(s, "주"):
+		s, unit = strings.TrimSuffix(strings.TrimSuffix(s, "w"), "주"), 7
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("일 수가 아닙니다: %s", s)
+	}
+	return float64(n) * unit, nil
+}
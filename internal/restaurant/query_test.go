@@ -0,0 +1,169 @@
+package restaurant
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func queryTestData() *RestaurantData {
+	list := testRestaurants("국밥집", "스시집", "라멘집", "김밥천국")
+	list[0].Rating, list[0].Categories, list[0].Locations = 4.5, []string{"국밥"}, []string{"역삼동"}
+	list[0].Menus = []Menu{{Name: "순대국", Price: 9000}}
+	list[1].Rating, list[1].Categories, list[1].Locations = 4.8, []string{"스시"}, []string{"삼성동"}
+	list[1].Menus = []Menu{{Name: "모둠초밥", Price: 18000}}
+	list[2].Rating, list[2].Categories, list[2].Locations = 3.5, []string{"라멘"}, []string{"마포구"}
+	list[2].Menus = []Menu{{Name: "돈코츠라멘", Price: 11000}}
+	list[3].Rating, list[3].Categories = 3.0, []string{"분식"}
+	list[3].Menus = []Menu{{Name: "김밥", Price: 3500}}
+	return &RestaurantData{
+		Restaurants: list,
+		Regions:     testRegions(),
+		Categories: []Category{
+			{Name: "한식", Children: []Category{{Name: "국밥"}}},
+			{Name: "일식", Children: []Category{{Name: "스시"}, {Name: "라멘"}}},
+		},
+		Visits: []Visit{
+			{Date: "2026-03-01", Restaurant: "국밥집"},
+			{Date: "2026-02-01", Restaurant: "라멘집"},
+		},
+	}
+}
+
+// 검색식에 맞는 식당 이름
+func queryNames(t *testing.T, data *RestaurantData, query string) []string {
+	t.Helper()
+	q, err := ParseQuery(query)
+	if err != nil {
+		t.Fatalf("검색식 해석 실패 %q: %v", query, err)
+	}
+	ctx := data.queryContext(time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+	names := []string{}
+	for i := range data.Restaurants {
+		if q.Matches(ctx, &data.Restaurants[i]) {
+			names = append(names, data.Restaurants[i].Name)
+		}
+	}
+	return names
+}
+
+func TestQuery_Matches(t *testing.T) {
+	data := queryTestData()
+	cases := []struct {
+		query string
+		want  []string
+	}{
+		{"", []string{"국밥집", "스시집", "라멘집", "김밥천국"}},
+		{"rating>=4", []string{"국밥집", "스시집"}},
+		{"rating>=4 category:일식", []string{"스시집"}},
+		{"category:일식 -visited", []string{"스시집"}},
+		{"category:한식 OR category:스시", []string{"국밥집", "스시집"}},
+		{"(category:한식 | category:라멘) rating<4", []string{"라멘집"}},
+		{"NOT category:일식 and price<10000", []string{"국밥집", "김밥천국"}},
+		{"price<=1.1만", []string{"국밥집", "라멘집", "김밥천국"}},
+		{"location:강남구", []string{"국밥집", "스시집"}},
+		{"location!=강남구 visited", []string{"라멘집"}},
+		{"last>14", []string{"스시집", "라멘집", "김밥천국"}},
+		{"last<1w", []string{"국밥집"}},
+		{"김밥", []string{"김밥천국"}},
+		{"순대", []string{"국밥집"}},
+		{"menu:초밥 평점>4", []string{"스시집"}},
+		{`name:"라멘집"`, []string{"라멘집"}},
+		{"-(category:일식)", []string{"국밥집", "김밥천국"}},
+		{"!(category:일식 | rating>=4)", []string{"김밥천국"}},
+		{"NOT (category:일식)", []string{"국밥집", "김밥천국"}},
+	}
+	for _, tc := range cases {
+		if got := queryNames(t, data, tc.query); !sameNames(got, tc.want) {
+			t.Errorf("%q: %q, 기대값 %q", tc.query, got, tc.want)
+		}
+	}
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+	cases := []struct {
+		query string
+		pos   int
+	}{
+		{"rating>=x", 8},
+		{"color:red", 0},
+		{"(category:한식", 0},
+		{"category:한식)", 11},
+		{"category>한식", 8},
+		{"rating>=", 8},
+		{"category:한식 OR", 14},
+		{`name:"라멘`, 5},
+		{"NOT )", 4},
+		{"rating>=4 -", 10},
+		{"- category:일식", 0},
+		{"category:한식 ! rating>=4", 12},
+		{"() category:한식", 1},
+	}
+	for _, tc := range cases {
+		_, err := ParseQuery(tc.query)
+		var qerr *QueryError
+		if !errors.As(err, &qerr) {
+			t.Errorf("%q: 검색식 오류가 아님: %v", tc.query, err)
+			continue
+		}
+		if qerr.Pos != tc.pos {
+			t.Errorf("%q: 오류 위치 %d, 기대값 %d (%v)", tc.query, qerr.Pos, tc.pos, err)
+		}
+		if !errors.Is(err, ErrInvalid) {
+			t.Errorf("%q: ErrInvalid가 아님", tc.query)
+		}
+	}
+}
+
+func TestQueryError_Caret(t *testing.T) {
+	_, err := ParseQuery("평점>=x")
+	var qerr *QueryError
+	if !errors.As(err, &qerr) {
+		t.Fatalf("검색식 오류가 아님: %v", err)
+	}
+	if got, want := qerr.Caret(), "평점>=x\n      ^"; got != want {
+		t.Fatalf("표시 %q, 기대값 %q", got, want)
+	}
+}
+
+func TestServiceList_Query(t *testing.T) {
+	s := newTestService(t, queryTestData(), time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+
+	list, err := s.List(ListOptions{Query: "category:일식 rating>4"})
+	if err != nil {
+		t.Fatalf("목록 조회 실패: %v", err)
+	}
+	if len(list) != 1 || list[0].Name != "스시집" {
+		t.Fatalf("검색식으로 걸러지지 않음: %v", list)
+	}
+	if _, err := s.List(ListOptions{Query: "rating>>4"}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("잘못된 검색식에 ErrInvalid가 아님: %v", err)
+	}
+}
+
+func TestSearchFilter_Query(t *testing.T) {
+	data := queryTestData()
+	ctx := data.queryContext(time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+	filter, err := (&SearchFilter{Categories: []string{"일식"}, Query: "rating>=4"}).compile()
+	if err != nil {
+		t.Fatalf("필터 해석 실패: %v", err)
+	}
+	if !filter.matches(ctx, &data.Restaurants[1]) || filter.matches(ctx, &data.Restaurants[2]) {
+		t.Fatal("필터의 검색식이 적용되지 않음")
+	}
+
+	// visited 필터는 검색식의 visited처럼 방문 기록이 있는 식당도 방문한 것으로 봄
+	visited := true
+	filter, err = (&SearchFilter{Visited: &visited}).compile()
+	if err != nil {
+		t.Fatalf("필터 해석 실패: %v", err)
+	}
+	if !filter.matches(ctx, &data.Restaurants[0]) || filter.matches(ctx, &data.Restaurants[1]) {
+		t.Fatal("방문 기록이 있는 식당이 visited 필터에 맞지 않음")
+	}
+
+	data.Search.Filters = []SearchFilter{{Name: "잘못됨", Query: "rating>="}}
+	if err := data.Validate(); err == nil {
+		t.Fatal("잘못된 검색식이 검증을 통과함")
+	}
+}
@@ -0,0 +1,192 @@
+package restaurant
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// 하루 동안 유지되는 추천 상태
+// 다시 뽑기로 거절한 식당은 날짜가 바뀌기 전까지 추천에서 제외한다.
+type RecommendState struct {
+	Date     string   `json:"date"`
+	Last     []string `json:"last"`
+	Rejected []string `json:"rejected"`
+}
+
+type RecommendOptions struct {
+	Count  int
+	Reroll bool
+	// 추천 모드 이름. 비어 있으면 cli_config.mode를 사용한다.
+	Mode string
+	// Seed가 있으면 같은 데이터에서 항상 같은 결과를 추천함 (디버깅, 테스트용)
+	Seed *int64
+	// Daily면 날짜, 팀 비밀값, 데이터 리비전으로 시드를 정해
+	// 같은 data.json을 가진 팀원 모두가 같은 식당을 추천받는다.
+	Daily bool
+	// 식당에 도착할 시각. 이 시각에 영업 중인 식당만 추천한다.
+	// 없으면 지금(Daily면 오늘 12시)으로 본다.
+	At *time.Time
+	// 기준 위치 이름. 비어 있으면 첫 번째 기준 위치에서 출발한다고 본다.
+	From string
+	// 0보다 크면 기준 위치에서 이 시간 안에 걸어갈 수 있는 식당만 추천한다.
+	MaxWalk time.Duration
+	// 지역 이름이나 별칭. 비어 있지 않으면 이 지역(하위 지역 포함)의 식당만 추천한다.
+	Region string
+	// 검색식. 비어 있지 않으면 검색식에 맞는 식당만 추천한다.
+	Query string
+	// 0보다 크면 가장 싼 한 끼가 이 가격 안에 드는 식당만 추천하고, 메뉴도 이 가격 안에서 추천한다.
+	// 메뉴 가격을 모르는 식당은 cli_config.no_menu_policy에 따른다.
+	Budget int
+	// 같이 먹는 사람들의 식단 프로필 이름. 모두가 먹을 수 있는 메뉴가 있는 식당만 추천한다.
+	// 비어 있으면 cli_config.default_profiles를 쓴다.
+	Profiles []string
+	// Preview면 직전 추천을 기록하지 않는다.
+	// 위키가 페이지를 열 때마다 추천을 받아도 jmc reroll, jmc accept가 다루는 추천이 바뀌지 않게 한다.
+	Preview bool
+}
+
+// 날짜가 바뀌었으면 상태를 초기화함
+func (st *RecommendState) resetIfStale(today string) {
+	if st.Date == today {
+		return
+	}
+	st.Date = today
+	st.Last = []string{}
+	st.Rejected = []string{}
+}
+
+func (st *RecommendState) reject(names []string) {
+	for _, name := range names {
+		if !contains(st.Rejected, name) {
+			st.Rejected = append(st.Rejected, name)
+		}
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// 식당을 추천하고 추천 결과를 오늘의 상태로 기록함
+// Reroll이면 직전 추천을 거절 목록에 넣고 다시 뽑는다.
+func (s *Service) Recommend(opts RecommendOptions) ([]Candidate, error) {
+	picks, _, err := s.recommend(opts, false)
+	return picks, err
+}
+
+// Recommend와 같지만 다양성 규칙처럼 반드시 지키는 규칙에 걸려 뺀 후보도 이유(Explain.Excluded)와 함께 반환함
+func (s *Service) RecommendExplained(opts RecommendOptions) (picks, excluded []Candidate, err error) {
+	return s.recommend(opts, true)
+}
+
+func (s *Service) recommend(opts RecommendOptions, explain bool) ([]Candidate, []Candidate, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	if opts.Count <= 0 {
+		opts.Count = 1
+	}
+	mode, err := data.CLIConfig.FindMode(opts.Mode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cond, err := data.walkConditions(opts.From, opts.MaxWalk)
+	if err != nil {
+		return nil, nil, err
+	}
+	cond.region = opts.Region
+	if opts.Budget < 0 {
+		return nil, nil, fmt.Errorf("%w: 예산은 0 이상이어야 합니다: %d", ErrInvalid, opts.Budget)
+	}
+	cond.budget = opts.Budget
+	cond.pace = data.spendingPace(s.now())
+	if cond.query, err = ParseQuery(opts.Query); err != nil {
+		return nil, nil, err
+	}
+	if cond.diet, err = data.dietFor(opts.Profiles); err != nil {
+		return nil, nil, err
+	}
+
+	now := s.now()
+	today := now.Format(dateLayout)
+	at := now
+	if opts.Daily {
+		at = time.Date(now.Year(), now.Month(), now.Day(), 12, 0, 0, 0, now.Location())
+	}
+	if opts.At != nil {
+		at = *opts.At
+	}
+	state := &data.Recommend
+	state.resetIfStale(today)
+	if opts.Reroll {
+		state.reject(state.Last)
+	}
+
+	seed := now.UnixNano()
+	if opts.Seed != nil {
+		seed = *opts.Seed
+	}
+	cond.exclude = state.Rejected
+	if opts.Daily {
+		// 개인의 거절 목록, 출발 위치, 식비 현황은 팀원마다 다르므로 오늘의 추천에는 적용하지 않음
+		// 대신 팀이 같이 먹으므로 모든 팀원의 식단 프로필을 지킨다.
+		seed = dailySeed(today, data.CLIConfig.TeamSecret, data.Revision())
+		cond = conditions{diet: data.teamDiet()}
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	picks := selectCandidates(data, mode, at, cond, r, opts.Count)
+
+	state.Last = make([]string, 0, len(picks))
+	for i := range picks {
+		state.Last = append(state.Last, picks[i].Name)
+		if order := data.pickMenus(&picks[i].Restaurant, OrderOptions{Count: 1, Budget: opts.Budget, diet: cond.diet}); len(order) > 0 {
+			picks[i].Order = &order[0]
+		}
+	}
+	if !opts.Preview {
+		if err := s.repo.Save(data); err != nil {
+			return nil, nil, err
+		}
+	}
+	if !explain {
+		return picks, nil, nil
+	}
+	excluded := []Candidate{}
+	for _, c := range scoreCandidates(data, mode, at, cond) {
+		if c.Explain.Excluded != "" {
+			excluded = append(excluded, c)
+		}
+	}
+	return picks, excluded, nil
+}
+
+// 식당 목록의 내용으로 만든 리비전
+// 식당 목록이 같으면 방문 기록이나 설정이 달라도 같은 값을 가진다.
+func (d *RestaurantData) Revision() string {
+	bytes, err := json.Marshal(d.Restaurants)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(bytes)
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+func dailySeed(date, secret, revision string) int64 {
+	sum := sha256.Sum256([]byte(date + "\x00" + secret + "\x00" + revision))
+	return int64(binary.BigEndian.Uint64(sum[:8]))
+}
@@ -0,0 +1,155 @@
+package restaurant
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// data를 임시 파일에 저장한 서비스. now가 0이 아니면 서비스의 시계를 그 시각에 고정한다.
+func newTestService(t *testing.T, data *RestaurantData, now time.Time) *Service {
+	t.Helper()
+	repo := NewRepository(filepath.Join(t.TempDir(), "data.json"))
+	if err := repo.Save(data); err != nil {
+		t.Fatalf("테스트 데이터 저장 실패: %v", err)
+	}
+	s := NewService(repo)
+	if !now.IsZero() {
+		s.now = func() time.Time { return now }
+	}
+	return s
+}
+
+// 이름 목록이 순서까지 같은지 확인함
+func sameNames(got, want []string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func testRestaurants(names ...string) []Restaurant {
+	list := make([]Restaurant, 0, len(names))
+	for _, name := range names {
+		list = append(list, Restaurant{Name: name, Categories: []string{}, Locations: []string{}})
+	}
+	return list
+}
+
+func TestServiceRecommend_RerollExcludesForTheDay(t *testing.T) {
+	today := time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local)
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("a", "b")}, today)
+
+	first, err := s.Recommend(RecommendOptions{Count: 1})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	second, err := s.Recommend(RecommendOptions{Count: 1, Reroll: true})
+	if err != nil {
+		t.Fatalf("다시 뽑기 실패: %v", err)
+	}
+	if len(second) != 1 || second[0].Name == first[0].Name {
+		t.Fatalf("거절한 %s가 다시 추천됨: %v", first[0].Name, second)
+	}
+
+	// 파일에 저장된 거절 목록이 다음 호출에도 유지되어야 함
+	third, err := s.Recommend(RecommendOptions{Count: 1, Reroll: true})
+	if err != nil {
+		t.Fatalf("다시 뽑기 실패: %v", err)
+	}
+	if len(third) != 0 {
+		t.Fatalf("모두 거절했는데 추천됨: %v", third)
+	}
+
+	// 다음 날에는 거절 목록이 초기화됨
+	s.now = func() time.Time { return today.AddDate(0, 0, 1) }
+	next, err := s.Recommend(RecommendOptions{Count: 2})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	if len(next) != 2 {
+		t.Fatalf("다음 날에는 2개를 기대했지만 %d개", len(next))
+	}
+}
+
+func TestServiceRecommend_PreviewKeepsLast(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("a", "b", "c", "d", "e")}, time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+	first, err := s.Recommend(RecommendOptions{Count: 1})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	for seed := int64(0); seed < 5; seed++ {
+		if _, err := s.Recommend(RecommendOptions{Count: 2, Seed: &seed, Preview: true}); err != nil {
+			t.Fatalf("미리보기 추천 실패: %v", err)
+		}
+	}
+	data, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("데이터 읽기 실패: %v", err)
+	}
+	if !sameNames(data.Recommend.Last, namesOf(first)) {
+		t.Fatalf("미리보기가 직전 추천을 바꿈: %q, 기대값 %q", data.Recommend.Last, namesOf(first))
+	}
+}
+
+func TestServiceRecommend_SeedIsReproducible(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("a", "b", "c", "d", "e")}, time.Time{})
+	seed := int64(42)
+
+	first, err := s.Recommend(RecommendOptions{Count: 3, Seed: &seed})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	second, err := s.Recommend(RecommendOptions{Count: 3, Seed: &seed})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	for i := range first {
+		if first[i].Name != second[i].Name {
+			t.Fatalf("같은 시드인데 결과가 다름: %v, %v", first, second)
+		}
+	}
+}
+
+func TestServiceRecommend_DailyIgnoresPersonalState(t *testing.T) {
+	today := time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local)
+	alice := newTestService(t, &RestaurantData{Restaurants: testRestaurants("a", "b", "c", "d", "e")}, today)
+	bob := newTestService(t, &RestaurantData{Restaurants: testRestaurants("a", "b", "c", "d", "e")}, today.Add(time.Hour))
+
+	// bob은 이미 몇 곳을 거절했음
+	for i := 0; i < 3; i++ {
+		if _, err := bob.Recommend(RecommendOptions{Count: 1, Reroll: true}); err != nil {
+			t.Fatalf("다시 뽑기 실패: %v", err)
+		}
+	}
+
+	a, err := alice.Recommend(RecommendOptions{Count: 1, Daily: true})
+	if err != nil {
+		t.Fatalf("오늘의 추천 실패: %v", err)
+	}
+	b, err := bob.Recommend(RecommendOptions{Count: 1, Daily: true})
+	if err != nil {
+		t.Fatalf("오늘의 추천 실패: %v", err)
+	}
+	if a[0].Name != b[0].Name {
+		t.Fatalf("같은 데이터인데 오늘의 추천이 다름: %s, %s", a[0].Name, b[0].Name)
+	}
+}
+
+func TestDailySeed_DependsOnSecretAndRevision(t *testing.T) {
+	base := dailySeed("2026-03-02", "secret", "rev")
+	if base == dailySeed("2026-03-02", "other", "rev") {
+		t.Fatal("팀 비밀값이 달라도 시드가 같음")
+	}
+	if base == dailySeed("2026-03-02", "secret", "rev2") {
+		t.Fatal("리비전이 달라도 시드가 같음")
+	}
+	if base == dailySeed("2026-03-03", "secret", "rev") {
+		t.Fatal("날짜가 달라도 시드가 같음")
+	}
+}
@@ -0,0 +1,35 @@
+package restaurant
+
+// 지역 분류
+// 서울 > 강남구 > 역삼동 > 역삼역 2번 출구처럼 상위 지역 아래에 하위 지역을 둔다.
+// 식당의 locations에는 지역 이름을 쓴다.
+type Region = Term
+
+func restaurantLocations(r *Restaurant) []string {
+	return r.Locations
+}
+
+// 식당의 위치를 지역 분류에 맞춰 정리함
+// 별칭은 지역 이름으로 바꾸고 빈 값과 중복은 뺀다.
+func (d *RestaurantData) normalizeLocations(r *Restaurant) {
+	r.Locations = buildTermIndex(d.Regions).normalize(r.Locations)
+}
+
+type LocationTree struct {
+	Regions []TermNode `json:"regions"`
+	// 지역 분류에 없는 위치
+	Others []TermNode `json:"others"`
+}
+
+// 지역 분류와 지역별 식당 수
+func (s *Service) Locations() (*LocationTree, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	idx := buildTermIndex(data.Regions)
+	return &LocationTree{
+		Regions: countTerms(data.Regions, idx, data.Restaurants, restaurantLocations),
+		Others:  otherTerms(idx, data.Restaurants, restaurantLocations),
+	}, nil
+}
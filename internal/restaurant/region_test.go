@@ -0,0 +1,96 @@
+package restaurant
+
+import (
+	"testing"
+	"time"
+)
+
+func testRegions() []Region {
+	return []Region{
+		{Name: "서울", Children: []Region{
+			{Name: "강남구", Aliases: []string{"gangnam"}, Children: []Region{
+				{Name: "역삼동", Children: []Region{
+					{Name: "역삼역 2번 출구", Aliases: []string{"역삼 2번"}},
+				}},
+				{Name: "삼성동"},
+			}},
+			{Name: "마포구"},
+		}},
+	}
+}
+
+func regionTestData() *RestaurantData {
+	list := testRestaurants("역삼", "삼성", "마포", "기타")
+	list[0].Locations = []string{"역삼역 2번 출구"}
+	list[1].Locations = []string{"삼성동"}
+	list[2].Locations = []string{"마포구"}
+	list[3].Locations = []string{"판교"}
+	return &RestaurantData{Restaurants: list, Regions: testRegions()}
+}
+
+func TestRepositoryCreate_NormalizesLocations(t *testing.T) {
+	s := newTestService(t, regionTestData(), time.Time{})
+	item := testRestaurants("새 식당")[0]
+	item.Locations = []string{" Gangnam ", "역삼  2번", "강남구", ""}
+	if err := s.Create(item); err != nil {
+		t.Fatalf("추가 실패: %v", err)
+	}
+	data, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("데이터 읽기 실패: %v", err)
+	}
+	got := data.Restaurants[len(data.Restaurants)-1].Locations
+	if !sameNames(got, []string{"강남구", "역삼역 2번 출구"}) {
+		t.Fatalf("별칭이 정리되지 않음: %q", got)
+	}
+}
+
+func TestServiceList_RegionMatchesChildren(t *testing.T) {
+	s := newTestService(t, regionTestData(), time.Time{})
+
+	list, err := s.List(ListOptions{Region: "gangnam"})
+	if err != nil {
+		t.Fatalf("목록 조회 실패: %v", err)
+	}
+	if got := listingNames(list); !sameNames(got, []string{"역삼", "삼성"}) {
+		t.Fatalf("강남구의 하위 지역 식당 2개를 기대했지만 %q", got)
+	}
+
+	list, err = s.List(ListOptions{Region: "역삼동"})
+	if err != nil {
+		t.Fatalf("목록 조회 실패: %v", err)
+	}
+	if got := listingNames(list); !sameNames(got, []string{"역삼"}) {
+		t.Fatalf("역삼동 식당만 기대했지만 %q", got)
+	}
+}
+
+func TestServiceLocations_Counts(t *testing.T) {
+	s := newTestService(t, regionTestData(), time.Time{})
+
+	tree, err := s.Locations()
+	if err != nil {
+		t.Fatalf("지역 조회 실패: %v", err)
+	}
+	seoul := tree.Regions[0]
+	if seoul.Count != 3 {
+		t.Fatalf("서울에 3개를 기대했지만 %d", seoul.Count)
+	}
+	gangnam := seoul.Children[0]
+	if gangnam.Count != 2 || gangnam.Children[0].Count != 1 {
+		t.Fatalf("강남구 2개, 역삼동 1개를 기대했지만 %+v", gangnam)
+	}
+	if len(tree.Others) != 1 || tree.Others[0].Name != "판교" || tree.Others[0].Count != 1 {
+		t.Fatalf("분류에 없는 위치로 판교를 기대했지만 %+v", tree.Others)
+	}
+}
+
+func TestRestaurantDataValidate_DuplicateRegion(t *testing.T) {
+	data := RestaurantData{
+		Restaurants: []Restaurant{},
+		Regions:     []Region{{Name: "서울", Children: []Region{{Name: "강남구"}}}, {Name: "경기", Aliases: []string{"강남구"}}},
+	}
+	if err := data.Validate(); err == nil {
+		t.Fatal("지역 이름이 겹치는데 에러가 발생하지 않음")
+	}
+}
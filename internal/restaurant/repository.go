@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+
+	"github.com/arch-spatula/jmc/internal/kakao"
 )
 
 type Repository struct {
@@ -49,11 +51,14 @@ func (r *Repository) Create(item Restaurant) error {
 		return err
 	}
 
+	normalizeRestaurant(&item)
+	data.normalizeTags(&item)
 	data.Restaurants = append(data.Restaurants, item)
 	return r.Save(data)
 }
 
-func (r *Repository) Update(name string, item Restaurant) error {
+// 식당을 고침. date는 메뉴 가격이 바뀌었을 때 기록할 날짜(YYYY-MM-DD)다.
+func (r *Repository) Update(name string, item Restaurant, date string) error {
 	data, err := r.FindAll()
 	if err != nil {
 		return err
@@ -61,12 +66,17 @@ func (r *Repository) Update(name string, item Restaurant) error {
 
 	for i, rest := range data.Restaurants {
 		if rest.Name == name {
+			normalizeRestaurant(&item)
+			preserveFields(&item, rest)
+			trackPrices(&item, &rest, date)
+			data.normalizeTags(&item)
 			data.Restaurants[i] = item
+			data.renameVisits(name, item.Name)
 			return r.Save(data)
 		}
 	}
 
-	return fmt.Errorf("식당을 찾을 수 없습니다: %s", name)
+	return fmt.Errorf("%w: %s", ErrNotFound, name)
 }
 
 func (r *Repository) Delete(name string) error {
@@ -78,14 +88,18 @@ func (r *Repository) Delete(name string) error {
 	for i, rest := range data.Restaurants {
 		if rest.Name == name {
 			data.Restaurants = append(data.Restaurants[:i], data.Restaurants[i+1:]...)
+			data.removeVisits(name)
 			return r.Save(data)
 		}
 	}
 
-	return fmt.Errorf("식당을 찾을 수 없습니다: %s", name)
+	return fmt.Errorf("%w: %s", ErrNotFound, name)
 }
 
 func normalizeRestaurant(rest *Restaurant) {
+	if id, ok := kakao.PlaceID(rest.KakaoURL); ok {
+		rest.KakaoURL = kakao.PlaceURL(id)
+	}
 	if rest.Locations == nil {
 		rest.Locations = []string{}
 	}
@@ -94,7 +108,32 @@ func normalizeRestaurant(rest *Restaurant) {
 	}
 }
 
-func (r *Repository) SaveBatch(req SaveRequest) (*RestaurantData, error) {
+// 위키 표에서 편집하지 않는 필드는 요청에 없으면 기존 값을 유지함
+// 위키는 표에 보이는 필드만 보내므로 그대로 덮어쓰면 영업시간, 좌표 같은 정보가 사라진다.
+func preserveFields(item *Restaurant, prev Restaurant) {
+	if item.Hours == nil {
+		item.Hours = prev.Hours
+	}
+	if item.Coord == nil {
+		item.Coord = prev.Coord
+	}
+	if item.Diet == nil {
+		item.Diet = prev.Diet
+	}
+	if item.Address == "" {
+		item.Address = prev.Address
+	}
+	// 위키에서는 보관을 풀 수 없으므로 보관한 식당은 그대로 둔다.
+	item.Archived = item.Archived || prev.Archived
+	for i := range item.Menus {
+		if j := prev.menuIndex(item.Menus[i].Name); j >= 0 && item.Menus[i].Diet == nil {
+			item.Menus[i].Diet = prev.Menus[j].Diet
+		}
+	}
+}
+
+// 위키에서 보낸 변경을 한 번에 저장함. date는 메뉴 가격이 바뀌었을 때 기록할 날짜(YYYY-MM-DD)다.
+func (r *Repository) SaveBatch(req SaveRequest, date string) (*RestaurantData, error) {
 	data, err := r.FindAll()
 	if err != nil {
 		return nil, err
@@ -102,9 +141,11 @@ func (r *Repository) SaveBatch(req SaveRequest) (*RestaurantData, error) {
 
 	for i := range req.New {
 		normalizeRestaurant(&req.New[i])
+		data.normalizeTags(&req.New[i])
 	}
 	for i := range req.Update {
 		normalizeRestaurant(&req.Update[i])
+		data.normalizeTags(&req.Update[i])
 	}
 
 	deleteSet := make(map[string]bool, len(req.Delete))
@@ -114,19 +155,33 @@ func (r *Repository) SaveBatch(req SaveRequest) (*RestaurantData, error) {
 
 	filtered := data.Restaurants[:0]
 	for _, rest := range data.Restaurants {
-		if !deleteSet[rest.Name] {
-			filtered = append(filtered, rest)
+		if deleteSet[rest.Name] {
+			data.removeVisits(rest.Name)
+			continue
 		}
+		filtered = append(filtered, rest)
 	}
 	data.Restaurants = filtered
 
+	// 이름을 바꾼 항목은 원래 이름으로 기존 식당을 찾는다.
+	renamedFrom := make(map[string]string, len(req.Renames))
+	for from, to := range req.Renames {
+		renamedFrom[to] = from
+	}
 	updateMap := make(map[string]Restaurant, len(req.Update))
 	for _, item := range req.Update {
-		updateMap[item.Name] = item
+		key := item.Name
+		if from, ok := renamedFrom[item.Name]; ok {
+			key = from
+		}
+		updateMap[key] = item
 	}
 	for i, rest := range data.Restaurants {
 		if updated, ok := updateMap[rest.Name]; ok {
+			preserveFields(&updated, rest)
+			trackPrices(&updated, &rest, date)
 			data.Restaurants[i] = updated
+			data.renameVisits(rest.Name, updated.Name)
 		}
 	}
 
@@ -0,0 +1,267 @@
+package restaurant
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// 점수에 더해진 요인 하나
+type Factor struct {
+	Name   string  `json:"name"`
+	Value  float64 `json:"value"`
+	Detail string  `json:"detail"`
+}
+
+// 추천 이유
+type Explanation struct {
+	Mode        string   `json:"mode"`
+	Score       float64  `json:"score"`
+	Probability float64  `json:"probability"`
+	Factors     []Factor `json:"factors"`
+	// 다양성 규칙처럼 반드시 지키는 규칙에 걸려 뺀 이유. 있으면 뽑히지 않는다.
+	Excluded string `json:"excluded,omitempty"`
+}
+
+// 점수가 매겨진 추천 후보
+// JSON으로는 식당 필드에 explain 필드가 추가된 형태가 된다.
+type Candidate struct {
+	Restaurant
+	Explain Explanation `json:"explain"`
+	// 기준 위치에서의 거리. 기준 위치나 좌표가 없으면 nil
+	Walk *Walk `json:"walk,omitempty"`
+	// 이 식당에서 주문할 메뉴. 추천할 메뉴가 없으면 nil
+	Order *MenuPick `json:"order,omitempty"`
+	// 메뉴 가격 범위. 가격이 있는 메뉴가 없으면 nil
+	Prices *PriceRange `json:"price_range,omitempty"`
+}
+
+func (c *Candidate) add(name string, value float64, detail string) {
+	c.Explain.Factors = append(c.Explain.Factors, Factor{Name: name, Value: value, Detail: detail})
+	c.Explain.Score += value
+}
+
+// 추천에 쓰는 가중치. 점수가 0 이하면 뽑히지 않는다.
+func (c *Candidate) weight() float64 {
+	if c.Explain.Excluded != "" || c.Explain.Score < 0 {
+		return 0
+	}
+	return c.Explain.Score
+}
+
+// 식당별 마지막 방문일
+func (d *RestaurantData) lastVisits() map[string]time.Time {
+	last := make(map[string]time.Time, len(d.Visits))
+	for _, v := range d.Visits {
+		date, err := time.ParseInLocation(dateLayout, v.Date, time.Local)
+		if err != nil {
+			continue
+		}
+		if date.After(last[v.Restaurant]) {
+			last[v.Restaurant] = date
+		}
+	}
+	return last
+}
+
+// 두 시각 사이의 날짜 차이
+func daysBetween(from, to time.Time) int {
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func hasAny(list []string, targets []string) bool {
+	for _, t := range targets {
+		if contains(list, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// 선택된 검색 필터
+// 저장할 때 검사하므로 해석하지 못하는 검색식이 있으면 선택되지 않은 것으로 본다.
+func (d *RestaurantData) selectedFilter() *filterMatcher {
+	sel := d.Search.Selected
+	if sel == nil || *sel < 0 || *sel >= len(d.Search.Filters) {
+		return nil
+	}
+	f, err := d.Search.Filters[*sel].compile()
+	if err != nil {
+		return nil
+	}
+	return f
+}
+
+// 검색식을 한 번만 해석해 둔 검색 필터
+type filterMatcher struct {
+	*SearchFilter
+	query *Query
+}
+
+func (f *SearchFilter) compile() (*filterMatcher, error) {
+	q, err := ParseQuery(f.Query)
+	if err != nil {
+		return nil, err
+	}
+	return &filterMatcher{SearchFilter: f, query: q}, nil
+}
+
+func (f *filterMatcher) matches(ctx *queryContext, r *Restaurant) bool {
+	if len(f.Categories) > 0 && !ctx.categories.matchesAny(r.Categories, f.Categories) {
+		return false
+	}
+	if len(f.Locations) > 0 && !ctx.regions.matchesAny(r.Locations, f.Locations) {
+		return false
+	}
+	// 검색식의 visited와 같이 방문 기록이 있는 식당도 방문한 식당으로 봄
+	if f.Visited != nil && !visitedPredicate(*f.Visited)(ctx, r) {
+		return false
+	}
+	return f.query.Matches(ctx, r)
+}
+
+// 조건에 맞고 도착했을 때 영업 중인 식당마다 모드에 따라 점수를 매김
+// 도착 시각은 now에 기준 위치에서 걸어가는 시간을 더한 시각이다.
+func scoreCandidates(data *RestaurantData, mode *Mode, now time.Time, cond conditions) []Candidate {
+	ctx := data.queryContext(now)
+	recent := data.recentVisits()
+	filter := data.selectedFilter()
+
+	candidates := make([]Candidate, 0, len(data.Restaurants))
+	for _, rest := range data.Restaurants {
+		if rest.Archived || contains(cond.exclude, rest.Name) {
+			continue
+		}
+		if cond.region != "" && !ctx.regions.matchesAny(rest.Locations, []string{cond.region}) {
+			continue
+		}
+		if !cond.query.Matches(ctx, &rest) {
+			continue
+		}
+		if !ctx.prices.withinBudget(&rest, cond.budget) {
+			continue
+		}
+		if !cond.diet.allowsRestaurant(&rest) {
+			continue
+		}
+		walk, ok := cond.walk(&data.CLIConfig, &rest)
+		if !ok {
+			continue
+		}
+		arrival := now
+		if walk != nil {
+			arrival = now.Add(walk.Duration())
+		}
+		if !rest.OpenAt(arrival) {
+			continue
+		}
+		c := Candidate{Restaurant: rest, Walk: walk, Prices: rest.priceRange()}
+		c.Explain.Mode = mode.Name
+		// 규칙에 걸린 후보는 점수를 매기지 않고 이유만 남김
+		if reason, blocked := mode.Diversity.blocked(&rest, recent, now); blocked {
+			c.Explain.Excluded = reason
+			c.add("excluded", 0, reason)
+			candidates = append(candidates, c)
+			continue
+		}
+		c.add("base", 1, "기본 점수")
+
+		if mode.RatingWeight != 0 {
+			c.add("rating", rest.Rating*mode.RatingWeight, fmt.Sprintf("평점 %.1f", rest.Rating))
+		}
+
+		lastVisit, visited := ctx.last[rest.Name]
+		if visited && mode.CooldownDays > 0 {
+			days := daysBetween(lastVisit, now)
+			if days < mode.CooldownDays {
+				remain := float64(mode.CooldownDays-days) / float64(mode.CooldownDays)
+				c.add("cooldown", -mode.CooldownPenalty*remain, fmt.Sprintf("%d일 전 방문 (쿨다운 %d일)", days, mode.CooldownDays))
+			}
+		}
+
+		if value, detail := mode.Diversity.penalty(&rest, recent); value != 0 {
+			c.add("diversity", value, detail)
+		}
+
+		if cond.pace != nil {
+			if value, detail := cond.pace.value(ctx.prices, &rest); value != 0 {
+				c.add("spending", value, detail)
+			}
+		}
+
+		if !visited && !rest.Visited && mode.NoveltyBonus != 0 {
+			c.add("novelty", mode.NoveltyBonus, "가본 적 없음")
+		}
+
+		if filter != nil && mode.FilterBonus != 0 && filter.matches(ctx, &rest) {
+			c.add("filter", mode.FilterBonus, fmt.Sprintf("필터 '%s'에 맞음", filter.Name))
+		}
+
+		for _, rule := range mode.Rules {
+			if len(rule.Months) > 0 && !containsInt(rule.Months, int(now.Month())) {
+				continue
+			}
+			if len(rule.Categories) > 0 && !ctx.categories.matchesAny(rest.Categories, rule.Categories) {
+				continue
+			}
+			c.add("rule", rule.Bonus, fmt.Sprintf("규칙 '%s'", rule.Name))
+		}
+
+		candidates = append(candidates, c)
+	}
+
+	total := 0.0
+	for i := range candidates {
+		total += candidates[i].weight()
+	}
+	for i := range candidates {
+		if total > 0 {
+			candidates[i].Explain.Probability = candidates[i].weight() / total
+		}
+	}
+	return candidates
+}
+
+func containsInt(list []int, n int) bool {
+	for _, v := range list {
+		if v == n {
+			return true
+		}
+	}
+	return false
+}
+
+// 가중치에 비례해 중복 없이 n개를 뽑음
+// 남은 후보의 가중치가 모두 0이면 더 뽑지 않는다.
+func pickWeighted(r *rand.Rand, candidates []Candidate, n int) []Candidate {
+	pool := make([]Candidate, len(candidates))
+	copy(pool, candidates)
+
+	picks := make([]Candidate, 0, n)
+	for len(picks) < n && len(pool) > 0 {
+		total := 0.0
+		for i := range pool {
+			total += pool[i].weight()
+		}
+		if total <= 0 {
+			break
+		}
+		x := r.Float64() * total
+		idx := -1
+		for i := range pool {
+			if pool[i].weight() == 0 {
+				continue
+			}
+			idx = i
+			x -= pool[i].weight()
+			if x < 0 {
+				break
+			}
+		}
+		picks = append(picks, pool[idx])
+		pool = append(pool[:idx], pool[idx+1:]...)
+	}
+	return picks
+}
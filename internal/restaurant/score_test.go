@@ -0,0 +1,112 @@
+package restaurant
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func candidatesOf(names ...string) []Candidate {
+	list := make([]Candidate, 0, len(names))
+	for _, r := range testRestaurants(names...) {
+		c := Candidate{Restaurant: r}
+		c.add("base", 1, "")
+		list = append(list, c)
+	}
+	return list
+}
+
+func factorValue(c Candidate, name string) (float64, bool) {
+	for _, f := range c.Explain.Factors {
+		if f.Name == name {
+			return f.Value, true
+		}
+	}
+	return 0, false
+}
+
+func TestPickWeighted_Distinct(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	picks := pickWeighted(r, candidatesOf("a", "b", "c", "d"), 3)
+	if len(picks) != 3 {
+		t.Fatalf("3개를 기대했지만 %d개", len(picks))
+	}
+	seen := map[string]bool{}
+	for _, p := range picks {
+		if seen[p.Name] {
+			t.Fatalf("중복 추천: %s", p.Name)
+		}
+		seen[p.Name] = true
+	}
+}
+
+func TestPickWeighted_SkipsZeroWeight(t *testing.T) {
+	list := candidatesOf("a", "b")
+	list[0].add("cooldown", -5, "")
+	r := rand.New(rand.NewSource(1))
+	picks := pickWeighted(r, list, 2)
+	if len(picks) != 1 || picks[0].Name != "b" {
+		t.Fatalf("점수가 0 이하인 a는 제외되어야 하지만 %v", picks)
+	}
+}
+
+func TestScoreCandidates_Factors(t *testing.T) {
+	now := time.Date(2026, 7, 10, 12, 0, 0, 0, time.Local)
+	list := testRestaurants("국밥집", "냉면집", "새식당")
+	list[0].Rating = 4
+	list[1].Categories = []string{"냉면"}
+	list[1].Visited = true
+	data := &RestaurantData{
+		Restaurants: list,
+		Visits:      []Visit{{Restaurant: "국밥집", Date: "2026-07-08"}},
+	}
+	mode := &Mode{
+		Name:            "테스트",
+		RatingWeight:    1,
+		CooldownDays:    4,
+		CooldownPenalty: 2,
+		NoveltyBonus:    3,
+		Rules:           []Rule{{Name: "여름 냉면", Months: []int{6, 7, 8}, Categories: []string{"냉면"}, Bonus: 1.5}},
+	}
+
+	candidates := scoreCandidates(data, mode, now, conditions{})
+	if len(candidates) != 3 {
+		t.Fatalf("후보 3개를 기대했지만 %d개", len(candidates))
+	}
+
+	if v, _ := factorValue(candidates[0], "rating"); v != 4 {
+		t.Fatalf("rating 요인 4를 기대했지만 %v", v)
+	}
+	// 2일 전 방문, 쿨다운 4일 → 남은 비율 0.5
+	if v, _ := factorValue(candidates[0], "cooldown"); v != -1 {
+		t.Fatalf("cooldown 요인 -1을 기대했지만 %v", v)
+	}
+	if v, _ := factorValue(candidates[1], "rule"); v != 1.5 {
+		t.Fatalf("계절 규칙 1.5를 기대했지만 %v", v)
+	}
+	if _, ok := factorValue(candidates[1], "novelty"); ok {
+		t.Fatal("방문한 식당에 novelty 요인이 붙음")
+	}
+	if v, _ := factorValue(candidates[2], "novelty"); v != 3 {
+		t.Fatalf("novelty 요인 3을 기대했지만 %v", v)
+	}
+
+	sum := 0.0
+	for _, c := range candidates {
+		sum += c.Explain.Probability
+	}
+	if sum < 0.999 || sum > 1.001 {
+		t.Fatalf("선택 확률의 합이 1이 아님: %v", sum)
+	}
+}
+
+func TestCLIConfigFindMode(t *testing.T) {
+	c := CLIConfig{}
+	mode, err := c.FindMode("")
+	if err != nil || mode.Name != DefaultModes()[0].Name {
+		t.Fatalf("기본 모드를 기대했지만 %v, %v", mode, err)
+	}
+	if _, err := c.FindMode("없는모드"); err == nil {
+		t.Fatal("없는 모드인데 에러가 발생하지 않음")
+	}
+}
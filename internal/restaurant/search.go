@@ -0,0 +1,65 @@
+package restaurant
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arch-spatula/jmc/internal/search"
+)
+
+// 검색 필드별 가중치
+const (
+	searchWeightName        = 3
+	searchWeightMenu        = 2
+	searchWeightTag         = 1.5
+	searchWeightDescription = 1
+)
+
+type SearchResult struct {
+	Restaurant
+	Score   float64        `json:"score"`
+	Matches []search.Match `json:"matches"`
+}
+
+// 식당 이름, 메뉴, 카테고리, 위치, 소감을 색인함
+func (d *RestaurantData) searchIndex() *search.Index {
+	docs := make([]search.Document, 0, len(d.Restaurants))
+	for _, rest := range d.Restaurants {
+		fields := []search.Field{{Name: "name", Text: rest.Name, Weight: searchWeightName}}
+		for _, m := range rest.Menus {
+			fields = append(fields, search.Field{Name: "menu", Text: m.Name, Weight: searchWeightMenu})
+		}
+		for _, c := range rest.Categories {
+			fields = append(fields, search.Field{Name: "category", Text: c, Weight: searchWeightTag})
+		}
+		for _, l := range rest.Locations {
+			fields = append(fields, search.Field{Name: "location", Text: l, Weight: searchWeightTag})
+		}
+		fields = append(fields, search.Field{Name: "description", Text: rest.Description, Weight: searchWeightDescription})
+		docs = append(docs, search.Document{ID: rest.Name, Fields: fields})
+	}
+	return search.NewIndex(docs)
+}
+
+// 식당을 검색해 점수가 높은 순서로 limit개까지 반환함. limit이 0 이하면 모두 반환한다.
+// 초성, 오타, 로마자 검색을 지원한다.
+func (s *Service) Search(query string, limit int) ([]SearchResult, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("%w: 검색어가 필요합니다", ErrInvalid)
+	}
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	found := data.searchIndex().Search(query, limit)
+	results := make([]SearchResult, 0, len(found))
+	for _, f := range found {
+		rest, err := data.findRestaurant(f.ID)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, SearchResult{Restaurant: *rest, Score: f.Score, Matches: f.Matches})
+	}
+	return results, nil
+}
@@ -0,0 +1,25 @@
+package restaurant
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestServiceSearch(t *testing.T) {
+	list := testRestaurants("김밥천국", "순대국")
+	list[1].Menus = []Menu{{Name: "김밥"}}
+	s := newTestService(t, &RestaurantData{Restaurants: list}, time.Time{})
+
+	results, err := s.Search("ㄱㅂ", 0)
+	if err != nil {
+		t.Fatalf("검색 실패: %v", err)
+	}
+	if len(results) != 2 || results[0].Name != "김밥천국" {
+		t.Fatalf("이름이 맞은 김밥천국이 먼저 와야 함: %+v", results)
+	}
+
+	if _, err := s.Search(" ", 0); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("빈 검색어에 ErrInvalid를 기대했지만 %v", err)
+	}
+}
@@ -1,13 +1,22 @@
 package restaurant
 
-import "math/rand"
+import (
+	"fmt"
+	"time"
+)
 
 type Service struct {
 	repo *Repository
+	now  func() time.Time
 }
 
 func NewService(repo *Repository) *Service {
-	return &Service{repo: repo}
+	return &Service{repo: repo, now: time.Now}
+}
+
+// 서비스의 시계로 본 지금 시각. 요청의 시각("12:30" 등)도 이 시각을 기준으로 해석한다.
+func (s *Service) Now() time.Time {
+	return s.now()
 }
 
 func (s *Service) GetAll() (*RestaurantData, error) {
@@ -18,8 +27,49 @@ func (s *Service) Create(item Restaurant) error {
 	return s.repo.Create(item)
 }
 
+// 식당을 검증하고 이름이나 카카오맵 장소가 이미 있는 식당과 겹치지 않으면 추가함
+func (s *Service) Add(item Restaurant) (*Restaurant, error) {
+	item.Name = cleanTerm(item.Name)
+	normalizeRestaurant(&item)
+	if err := item.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, rest := range data.Restaurants {
+		if rest.Name == item.Name {
+			return nil, fmt.Errorf("%w: 이미 있는 식당입니다: %s", ErrInvalid, rest.Name)
+		}
+		if item.KakaoURL != "" && rest.KakaoURL == item.KakaoURL {
+			return nil, fmt.Errorf("%w: 이미 %s(으)로 등록된 장소입니다", ErrInvalid, rest.Name)
+		}
+	}
+	if err := s.repo.Create(item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// 식당들을 보관하거나 보관을 풂. 보관한 식당은 추천하지 않는다.
+func (s *Service) SetArchived(names []string, archived bool) error {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		rest, err := data.findRestaurant(name)
+		if err != nil {
+			return err
+		}
+		rest.Archived = archived
+	}
+	return s.repo.Save(data)
+}
+
 func (s *Service) Update(name string, item Restaurant) error {
-	return s.repo.Update(name, item)
+	return s.repo.Update(name, item, s.today())
 }
 
 func (s *Service) Delete(name string) error {
@@ -27,17 +77,5 @@ func (s *Service) Delete(name string) error {
 }
 
 func (s *Service) SaveBatch(req SaveRequest) (*RestaurantData, error) {
-	return s.repo.SaveBatch(req)
-}
-
-func (s *Service) Recommend() (*Restaurant, error) {
-	data, err := s.repo.FindAll()
-	if err != nil {
-		return nil, err
-	}
-	if len(data.Restaurants) == 0 {
-		return nil, nil
-	}
-	idx := rand.Intn(len(data.Restaurants))
-	return &data.Restaurants[idx], nil
+	return s.repo.SaveBatch(req, s.today())
 }
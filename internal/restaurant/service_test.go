@@ -0,0 +1,81 @@
+package restaurant
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestServiceAdd_NormalizesKakaoURL(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("a")}, time.Time{})
+	rest, err := s.Add(Restaurant{Name: " 국밥집 ", KakaoURL: "https://map.kakao.com/link/map/26338954"})
+	if err != nil {
+		t.Fatalf("식당 추가 실패: %v", err)
+	}
+	if rest.Name != "국밥집" || rest.KakaoURL != "https://place.map.kakao.com/26338954" {
+		t.Fatalf("정리된 이름과 정규 URL을 기대했지만 %q, %q", rest.Name, rest.KakaoURL)
+	}
+	saved, err := s.Get("국밥집")
+	if err != nil {
+		t.Fatalf("저장된 식당 조회 실패: %v", err)
+	}
+	if saved.KakaoURL != rest.KakaoURL || saved.Categories == nil {
+		t.Fatalf("정리된 식당이 저장되어야 하지만 %+v", saved)
+	}
+}
+
+func TestServiceAdd_Duplicates(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("a")}, time.Time{})
+	if _, err := s.Add(Restaurant{Name: "a"}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("이름이 겹치면 ErrInvalid를 기대했지만 %v", err)
+	}
+	if _, err := s.Add(Restaurant{Name: "b", KakaoURL: "26338954"}); err != nil {
+		t.Fatalf("식당 추가 실패: %v", err)
+	}
+	if _, err := s.Add(Restaurant{Name: "c", KakaoURL: "https://place.map.kakao.com/m/26338954"}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("같은 장소면 ErrInvalid를 기대했지만 %v", err)
+	}
+}
+
+func TestServiceAdd_InvalidKakaoURL(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("a")}, time.Time{})
+	if _, err := s.Add(Restaurant{Name: "b", KakaoURL: "https://example.com/1"}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("ErrInvalid를 기대했지만 %v", err)
+	}
+}
+
+func TestServiceSetArchived_ExcludedFromRecommend(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("a", "b")}, time.Time{})
+	if err := s.SetArchived([]string{"a"}, true); err != nil {
+		t.Fatalf("보관 실패: %v", err)
+	}
+	for range 10 {
+		rec, err := s.Recommend(RecommendOptions{Count: 2})
+		if err != nil {
+			t.Fatalf("추천 실패: %v", err)
+		}
+		for _, c := range rec {
+			if c.Name == "a" {
+				t.Fatal("보관한 식당이 추천됨")
+			}
+		}
+	}
+
+	if err := s.SetArchived([]string{"a"}, false); err != nil {
+		t.Fatalf("보관 해제 실패: %v", err)
+	}
+	rest, err := s.Get("a")
+	if err != nil || rest.Archived {
+		t.Fatalf("보관이 풀려야 하지만 %+v, %v", rest, err)
+	}
+}
+
+func TestServiceSetArchived_UnknownRestaurant(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("a")}, time.Time{})
+	if err := s.SetArchived([]string{"a", "없는 식당"}, true); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("ErrNotFound를 기대했지만 %v", err)
+	}
+	if rest, err := s.Get("a"); err != nil || rest.Archived {
+		t.Fatalf("실패하면 아무것도 저장하지 않아야 하지만 %+v, %v", rest, err)
+	}
+}
@@ -0,0 +1,141 @@
+package restaurant
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+type SimulateOptions struct {
+	// 기준이 되는 모드. 전략만 바꿔가며 비교한다.
+	Mode string
+	// 시뮬레이션할 날 수. 0이면 방문 기록 수(없으면 30일)를 쓴다.
+	Steps int
+	Seed  int64
+}
+
+// 전략 하나의 시뮬레이션 결과
+type SimulationResult struct {
+	Strategy string `json:"strategy"`
+	Steps    int    `json:"steps"`
+	// 뽑은 식당의 실제 품질 평균 (5점 만점)
+	AvgRating float64 `json:"avg_rating"`
+	// 누적 후회: 매일 (가장 좋은 식당의 품질 - 뽑은 식당의 품질)의 합
+	Regret float64 `json:"regret"`
+	// 한 번이라도 뽑힌 서로 다른 식당 수와 그 비율
+	Distinct  int     `json:"distinct"`
+	Diversity float64 `json:"diversity"`
+}
+
+// 방문 기록을 재생해 전략별 추천 품질을 비교함
+// 식당의 실제 품질은 방문 평점의 평균으로 보고, 전략이 식당을 뽑으면 그 식당의 방문 평점 중 하나를
+// 무작위로 골라 새 방문 평점으로 돌려준다. 방문 평점이 없는 식당은 식당 rating(없으면 2.5)을 쓴다.
+func Simulate(data *RestaurantData, opts SimulateOptions) ([]SimulationResult, error) {
+	mode, err := data.CLIConfig.FindMode(opts.Mode)
+	if err != nil {
+		return nil, err
+	}
+	steps := opts.Steps
+	if steps <= 0 {
+		steps = len(data.Visits)
+	}
+	if steps <= 0 {
+		steps = 30
+	}
+
+	ratings := data.visitRatings()
+	quality := make(map[string]float64, len(data.Restaurants))
+	best := 0.0
+	for _, rest := range data.Restaurants {
+		q := meanRating(ratings[rest.Name], rest.Rating)
+		quality[rest.Name] = q
+		best = math.Max(best, q)
+	}
+
+	start := time.Now()
+	if first, ok := data.firstVisit(); ok {
+		start = first
+	}
+	// 매일 점심시간에 추천받는다고 봄
+	start = time.Date(start.Year(), start.Month(), start.Day(), 12, 0, 0, 0, time.Local)
+
+	results := make([]SimulationResult, 0, len(strategies))
+	for _, strategy := range strategies {
+		m := *mode
+		m.Strategy = strategy
+		sim := &RestaurantData{
+			Restaurants: data.Restaurants,
+			CLIConfig:   data.CLIConfig,
+			Search:      data.Search,
+			Visits:      []Visit{},
+		}
+		r := rand.New(rand.NewSource(opts.Seed))
+		feedback := rand.New(rand.NewSource(opts.Seed))
+
+		result := SimulationResult{Strategy: strategy, Steps: steps}
+		picked := map[string]bool{}
+		total := 0.0
+		for day := 0; day < steps; day++ {
+			now := start.AddDate(0, 0, day)
+			picks := selectCandidates(sim, &m, now, conditions{}, r, 1)
+			if len(picks) == 0 {
+				result.Regret += best
+				continue
+			}
+			name := picks[0].Name
+			q := quality[name]
+			total += q
+			result.Regret += best - q
+			picked[name] = true
+
+			rating := q
+			if list := ratings[name]; len(list) > 0 {
+				rating = list[feedback.Intn(len(list))]
+			}
+			sim.Visits = append(sim.Visits, Visit{
+				Restaurant: name,
+				Date:       now.Format(dateLayout),
+				Menus:      []string{},
+				Rating:     math.Round(rating*2) / 2,
+			})
+		}
+
+		result.AvgRating = total / float64(steps)
+		result.Distinct = len(picked)
+		if n := min(steps, len(data.Restaurants)); n > 0 {
+			result.Diversity = float64(result.Distinct) / float64(n)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func meanRating(ratings []float64, fallback float64) float64 {
+	if len(ratings) == 0 {
+		if fallback > 0 {
+			return fallback
+		}
+		return 2.5
+	}
+	sum := 0.0
+	for _, r := range ratings {
+		sum += r
+	}
+	return sum / float64(len(ratings))
+}
+
+// 가장 이른 방문일
+func (d *RestaurantData) firstVisit() (time.Time, bool) {
+	var first time.Time
+	found := false
+	for _, v := range d.Visits {
+		date, err := time.ParseInLocation(dateLayout, v.Date, time.Local)
+		if err != nil {
+			continue
+		}
+		if !found || date.Before(first) {
+			first, found = date, true
+		}
+	}
+	return first, found
+}
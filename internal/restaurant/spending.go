@@ -0,0 +1,207 @@
+package restaurant
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/arch-spatula/jmc/internal/holiday"
+)
+
+const monthLayout = "2006-01"
+
+// 식대 페이스를 넘었을 때 싼 식당에 주는 가산점의 최대 크기
+// 한 끼 가격이 식당들의 중간 가격보다 싼 만큼 더하고 비싼 만큼 뺀다.
+const spendingWeight = 1.5
+
+// 한 달 식비 현황
+type Spending struct {
+	Month string `json:"month"`
+	// cli_config.monthly_budget. 0이면 한도가 없다.
+	Limit  int `json:"limit"`
+	Total  int `json:"total"`
+	Visits int `json:"visits"`
+	// spend를 기록하지 않은 방문 수
+	Unrecorded int `json:"unrecorded"`
+	// 이번 달 근무일(주말과 공휴일 제외)과 오늘까지 지난 근무일
+	Workdays        int `json:"workdays"`
+	ElapsedWorkdays int `json:"elapsed_workdays"`
+	// 지금까지의 근무일 평균으로 계산한 월말 예상 지출
+	Projected int `json:"projected"`
+	// 남은 근무일(오늘 포함) 하루에 쓸 수 있는 금액. 한도가 없거나 남은 근무일이 없으면 0
+	DailyAllowance int            `json:"daily_allowance"`
+	ByCategory     []SpendingItem `json:"by_category"`
+	ByRestaurant   []SpendingItem `json:"by_restaurant"`
+}
+
+type SpendingItem struct {
+	Name   string `json:"name"`
+	Total  int    `json:"total"`
+	Visits int    `json:"visits"`
+}
+
+// 월말 예상 지출이 한도를 넘는지 확인함
+func (s *Spending) AheadOfPace() bool {
+	return s.Limit > 0 && s.Projected > s.Limit
+}
+
+func isWorkday(t time.Time) bool {
+	return t.Weekday() != time.Saturday && t.Weekday() != time.Sunday && !holiday.IsHoliday(t)
+}
+
+func addSpending(totals map[string]*SpendingItem, name string, spend int) {
+	if totals[name] == nil {
+		totals[name] = &SpendingItem{Name: name}
+	}
+	totals[name].Total += spend
+	totals[name].Visits++
+}
+
+// 금액이 큰 순서로 정렬한 항목 목록
+func spendingItems(totals map[string]*SpendingItem) []SpendingItem {
+	items := make([]SpendingItem, 0, len(totals))
+	for _, item := range totals {
+		items = append(items, *item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Total != items[j].Total {
+			return items[i].Total > items[j].Total
+		}
+		return items[i].Name < items[j].Name
+	})
+	return items
+}
+
+// month가 속한 달의 식비 현황을 now 기준으로 계산함
+// 카테고리별 지출은 식당의 첫 번째 카테고리의 최상위 카테고리로 묶는다.
+func (d *RestaurantData) spending(month, now time.Time) *Spending {
+	first := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.Local)
+	next := first.AddDate(0, 1, 0)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	s := &Spending{Month: first.Format(monthLayout), Limit: d.CLIConfig.MonthlyBudget}
+
+	remaining := 0
+	for day := first; day.Before(next); day = day.AddDate(0, 0, 1) {
+		if !isWorkday(day) {
+			continue
+		}
+		s.Workdays++
+		if day.Before(today) || day.Equal(today) {
+			s.ElapsedWorkdays++
+		}
+		if !day.Before(today) {
+			remaining++
+		}
+	}
+
+	categories := d.categoryIndex()
+	byCategory := map[string]*SpendingItem{}
+	byRestaurant := map[string]*SpendingItem{}
+	for _, v := range d.Visits {
+		date, err := time.ParseInLocation(dateLayout, v.Date, time.Local)
+		if err != nil || date.Before(first) || !date.Before(next) {
+			continue
+		}
+		s.Visits++
+		s.Total += v.Spend
+		if v.Spend == 0 {
+			s.Unrecorded++
+		}
+
+		category := "기타"
+		if rest, err := d.findRestaurant(v.Restaurant); err == nil && len(rest.Categories) > 0 {
+			category = cleanTerm(rest.Categories[0])
+			if path, ok := categories[termKey(category)]; ok {
+				category = path[0]
+			}
+		}
+		addSpending(byCategory, category, v.Spend)
+		addSpending(byRestaurant, v.Restaurant, v.Spend)
+	}
+	s.ByCategory = spendingItems(byCategory)
+	s.ByRestaurant = spendingItems(byRestaurant)
+
+	s.Projected = s.Total
+	if s.ElapsedWorkdays > 0 && s.ElapsedWorkdays < s.Workdays {
+		s.Projected = s.Total * s.Workdays / s.ElapsedWorkdays
+	}
+	if s.Limit > 0 && remaining > 0 {
+		s.DailyAllowance = max(s.Limit-s.Total, 0) / remaining
+	}
+	return s
+}
+
+// month(YYYY-MM)의 식비 현황. month가 비어 있으면 이번 달
+func (s *Service) Spending(month string) (*Spending, error) {
+	now := s.now()
+	target := now
+	if month != "" {
+		t, err := time.ParseInLocation(monthLayout, month, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("%w: 월은 YYYY-MM 형식이어야 합니다: %s", ErrInvalid, month)
+		}
+		target = t
+	}
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	return data.spending(target, now), nil
+}
+
+// 식대 페이스를 넘었을 때 싼 식당을 우선하는 점수 요인
+type spendingPace struct {
+	spending *Spending
+	// 식당들의 한 끼 가격의 중간값
+	median float64
+}
+
+// 페이스를 넘지 않았거나 가격을 아는 식당이 없으면 nil
+func (d *RestaurantData) spendingPace(now time.Time) *spendingPace {
+	spending := d.spending(now, now)
+	if !spending.AheadOfPace() {
+		return nil
+	}
+	index := d.priceIndex()
+	prices := []int{}
+	for i := range d.Restaurants {
+		if price, ok := index.mealPrice(&d.Restaurants[i]); ok {
+			prices = append(prices, price)
+		}
+	}
+	if len(prices) == 0 {
+		return nil
+	}
+	sort.Ints(prices)
+	median := float64(prices[len(prices)/2])
+	// 중간값이 0이면 가격 비율을 계산할 수 없다.
+	if median <= 0 {
+		return nil
+	}
+	return &spendingPace{spending: spending, median: median}
+}
+
+// 식당의 점수 요인. 가격을 모르면 0
+func (p *spendingPace) value(prices *priceIndex, r *Restaurant) (float64, string) {
+	price, ok := prices.mealPrice(r)
+	if !ok {
+		return 0, ""
+	}
+	ratio := math.Max(-1, math.Min(1, (p.median-float64(price))/p.median))
+	return spendingWeight * ratio, fmt.Sprintf("식대 페이스 초과 (예상 %s원 / 한도 %s원), 한 끼 %s원",
+		FormatPrice(p.spending.Projected), FormatPrice(p.spending.Limit), FormatPrice(price))
+}
+
+// 한 달 식대 한도를 정함. 0이면 한도를 없앤다.
+func (s *Service) SetMonthlyBudget(limit int) error {
+	if limit < 0 {
+		return fmt.Errorf("%w: 식대 한도는 0 이상이어야 합니다: %d", ErrInvalid, limit)
+	}
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return err
+	}
+	data.CLIConfig.MonthlyBudget = limit
+	return s.repo.Save(data)
+}
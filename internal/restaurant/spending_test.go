@@ -0,0 +1,149 @@
+package restaurant
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func spendingTestData() *RestaurantData {
+	data := budgetTestData("")
+	data.CLIConfig.MonthlyBudget = 60000
+	data.Visits = []Visit{
+		{Restaurant: "국밥집", Date: "2026-02-27", Spend: 9000},
+		{Restaurant: "국밥집", Date: "2026-03-03", Spend: 9000},
+		{Restaurant: "라멘집", Date: "2026-03-05", Spend: 12000},
+		{Restaurant: "분식집", Date: "2026-03-09"},
+	}
+	return data
+}
+
+func TestSpending_Month(t *testing.T) {
+	// 2026년 3월은 근무일이 21일(3/2 대체공휴일 제외)이고 3/10까지 6일이 지났다.
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local)
+	s := spendingTestData().spending(now, now)
+
+	if s.Month != "2026-03" || s.Total != 21000 || s.Visits != 3 || s.Unrecorded != 1 {
+		t.Fatalf("합계가 다름: %+v", s)
+	}
+	if s.Workdays != 21 || s.ElapsedWorkdays != 6 {
+		t.Fatalf("근무일 %d일 중 %d일, 기대값 21일 중 6일", s.Workdays, s.ElapsedWorkdays)
+	}
+	if s.Projected != 73500 || !s.AheadOfPace() {
+		t.Fatalf("예상 지출 %d, 기대값 73500 (한도 초과)", s.Projected)
+	}
+	// 남은 16일(오늘 포함) 동안 39000원
+	if s.DailyAllowance != 2437 {
+		t.Fatalf("하루 가능 금액 %d, 기대값 2437", s.DailyAllowance)
+	}
+
+	want := []SpendingItem{{"일식", 12000, 1}, {"기타", 9000, 1}, {"분식", 0, 1}}
+	if len(s.ByCategory) != len(want) {
+		t.Fatalf("카테고리별 지출 %+v, 기대값 %+v", s.ByCategory, want)
+	}
+	for i := range want {
+		if s.ByCategory[i] != want[i] {
+			t.Fatalf("카테고리별 지출 %+v, 기대값 %+v", s.ByCategory, want)
+		}
+	}
+	if s.ByRestaurant[0] != (SpendingItem{"라멘집", 12000, 1}) {
+		t.Fatalf("식당별 지출 %+v", s.ByRestaurant)
+	}
+}
+
+func TestSpending_PastMonth(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local)
+	s := spendingTestData().spending(time.Date(2026, 2, 1, 0, 0, 0, 0, time.Local), now)
+	if s.Total != 9000 || s.Projected != 9000 || s.DailyAllowance != 0 {
+		t.Fatalf("지난 달 현황이 다름: %+v", s)
+	}
+	if s.ElapsedWorkdays != s.Workdays {
+		t.Fatalf("지난 달의 근무일이 모두 지나지 않음: %d/%d", s.ElapsedWorkdays, s.Workdays)
+	}
+}
+
+func TestServiceSpending_Invalid(t *testing.T) {
+	s := newTestService(t, spendingTestData(), time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local))
+	if _, err := s.Spending("2026/03"); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("잘못된 월에 ErrInvalid가 아님: %v", err)
+	}
+	if err := s.SetMonthlyBudget(-1); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("음수 한도에 ErrInvalid가 아님: %v", err)
+	}
+}
+
+func TestServiceSetMonthlyBudget(t *testing.T) {
+	s := newTestService(t, spendingTestData(), time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local))
+	if err := s.SetMonthlyBudget(0); err != nil {
+		t.Fatalf("한도 변경 실패: %v", err)
+	}
+	got, err := s.Spending("")
+	if err != nil {
+		t.Fatalf("식비 현황 실패: %v", err)
+	}
+	if got.Limit != 0 || got.AheadOfPace() || got.DailyAllowance != 0 {
+		t.Fatalf("한도를 없앴는데 %+v", got)
+	}
+}
+
+func TestServiceRecommend_SpendingPace(t *testing.T) {
+	s := newTestService(t, spendingTestData(), time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local))
+	picks, err := s.Recommend(RecommendOptions{Count: 4})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	values := map[string]float64{}
+	for _, p := range picks {
+		for _, f := range p.Explain.Factors {
+			if f.Name == "spending" {
+				values[p.Name] = f.Value
+			}
+		}
+	}
+	// 한 끼 가격의 중간값은 18000원이므로 9000원인 국밥집만 가산점을 받는다.
+	if values["국밥집"] <= 0 || values["스시집"] != 0 {
+		t.Fatalf("식대 페이스 요인이 다름: %v", values)
+	}
+
+	if err := s.SetMonthlyBudget(100000); err != nil {
+		t.Fatalf("한도 변경 실패: %v", err)
+	}
+	picks, err = s.Recommend(RecommendOptions{Count: 4})
+	if err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	for _, p := range picks {
+		for _, f := range p.Explain.Factors {
+			if f.Name == "spending" {
+				t.Fatalf("페이스 안인데 %s에 식대 요인이 붙음", p.Name)
+			}
+		}
+	}
+}
+
+func TestSpendingPace_ZeroCategoryPrice(t *testing.T) {
+	data := spendingTestData()
+	for i := range data.Restaurants {
+		data.Restaurants[i].Menus = nil
+	}
+	data.CLIConfig.NoMenuPolicy = NoMenuCategory
+	data.CLIConfig.CategoryPrices = map[string]int{"일식": 0, "분식": 0}
+	if err := data.Validate(); err == nil {
+		t.Fatal("카테고리 가격 0을 허용함")
+	}
+	if price, ok := data.priceIndex().mealPrice(&data.Restaurants[2]); ok {
+		t.Fatalf("0원 카테고리 가격을 한 끼 가격으로 씀: %d", price)
+	}
+
+	// 검증을 거치지 않은 데이터로 추천해도 점수가 NaN이 되지 않아야 한다.
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local)
+	if p := data.spendingPace(now); p != nil {
+		t.Fatalf("가격을 아는 식당이 없는데 식대 페이스 요인이 생김: median %v", p.median)
+	}
+	for _, c := range scoreCandidates(data, &DefaultModes()[0], now, conditions{}) {
+		if math.IsNaN(c.Explain.Score) {
+			t.Fatalf("%s의 점수가 NaN", c.Name)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package restaurant
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// 정확히 최소 송금 횟수를 계산하는 최대 인원. 넘으면 큰 금액끼리 맞추는 방식으로 정산한다.
+const maxExactSettle = 16
+
+// 같이 먹은 점심 한 번의 계산서
+type Bill struct {
+	Restaurant string     `json:"restaurant"`
+	Date       string     `json:"date"`
+	Payer      string     `json:"payer"`
+	Items      []BillItem `json:"items"`
+}
+
+// 계산서의 메뉴 하나
+// People이 한 명이면 그 사람의 주문이고, 여러 명이면 나눠 먹은 메뉴다.
+// People이 비어 있으면 계산서의 모든 사람이 나눠 먹은 것으로 본다.
+type BillItem struct {
+	Menu string `json:"menu"`
+	// 0이면 식당 메뉴의 가격을 쓴다.
+	Price  int      `json:"price"`
+	People []string `json:"people"`
+}
+
+// 정산을 위해 보낸 돈
+type Transfer struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Amount int    `json:"amount"`
+	Date   string `json:"date,omitempty"`
+}
+
+// 한 사람의 몫 또는 잔액
+// 잔액이 양수면 받을 돈, 음수면 보낼 돈이다.
+type Share struct {
+	Person string `json:"person"`
+	Amount int    `json:"amount"`
+}
+
+// 지금까지의 잔액과 잔액을 0으로 만드는 송금 목록
+type Settlement struct {
+	Balances  []Share    `json:"balances"`
+	Transfers []Transfer `json:"transfers"`
+}
+
+func (b *Bill) Total() int {
+	total := 0
+	for _, item := range b.Items {
+		total += item.Price
+	}
+	return total
+}
+
+// 계산서에 나오는 사람들. 계산한 사람이 먼저 오고 나머지는 처음 나온 순서다.
+func (b *Bill) People() []string {
+	people := []string{b.Payer}
+	for _, item := range b.Items {
+		for _, p := range item.People {
+			if !contains(people, p) {
+				people = append(people, p)
+			}
+		}
+	}
+	return people
+}
+
+// 사람마다 내야 할 몫
+// 나눠 먹은 메뉴는 똑같이 나누고, 나누어 떨어지지 않는 원 단위는 앞사람부터 1원씩 더 낸다.
+func (b *Bill) Shares() []Share {
+	people := b.People()
+	owed := map[string]int{}
+	for _, item := range b.Items {
+		eaters := item.People
+		if len(eaters) == 0 {
+			eaters = people
+		}
+		each, rest := item.Price/len(eaters), item.Price%len(eaters)
+		for i, p := range eaters {
+			owed[p] += each
+			if i < rest {
+				owed[p]++
+			}
+		}
+	}
+	shares := make([]Share, 0, len(people))
+	for _, p := range people {
+		shares = append(shares, Share{Person: p, Amount: owed[p]})
+	}
+	return shares
+}
+
+// 사람 이름을 정리하고 메뉴 가격을 채운 뒤 검증함
+func (b *Bill) resolve(rest *Restaurant) error {
+	b.Payer = cleanTerm(b.Payer)
+	if b.Payer == "" {
+		return fmt.Errorf("계산한 사람(payer)은 필수입니다")
+	}
+	if len(b.Items) == 0 {
+		return fmt.Errorf("메뉴(items)가 하나 이상 필요합니다")
+	}
+	for i := range b.Items {
+		item := &b.Items[i]
+		item.Menu = cleanTerm(item.Menu)
+		if item.Menu == "" {
+			return fmt.Errorf("items[%d]: 메뉴 이름은 필수입니다", i)
+		}
+		if item.Price < 0 {
+			return fmt.Errorf("items[%d]: 가격은 0 이상이어야 합니다: %s", i, item.Menu)
+		}
+		if item.Price == 0 {
+			j := rest.menuIndex(item.Menu)
+			if j < 0 || rest.Menus[j].Price == 0 {
+				return fmt.Errorf("items[%d]: %s의 %s 가격을 알 수 없습니다. 가격을 적어주세요", i, rest.Name, item.Menu)
+			}
+			item.Menu = rest.Menus[j].Name
+			item.Price = rest.Menus[j].Price
+		}
+		people := []string{}
+		for _, p := range item.People {
+			if p = cleanTerm(p); p != "" && !contains(people, p) {
+				people = append(people, p)
+			}
+		}
+		item.People = people
+	}
+	return nil
+}
+
+// 계산서와 송금 기록을 모두 반영한 사람별 잔액. 잔액이 0인 사람은 뺀다.
+func (d *RestaurantData) balances() map[string]int {
+	net := map[string]int{}
+	for i := range d.Bills {
+		b := &d.Bills[i]
+		net[b.Payer] += b.Total()
+		for _, s := range b.Shares() {
+			net[s.Person] -= s.Amount
+		}
+	}
+	for _, t := range d.Payments {
+		net[t.From] += t.Amount
+		net[t.To] -= t.Amount
+	}
+	for p, amount := range net {
+		if amount == 0 {
+			delete(net, p)
+		}
+	}
+	return net
+}
+
+// 잔액을 0으로 만드는 송금 횟수가 가장 적은 송금 목록
+// 합이 0인 무리로 최대한 많이 나누면 무리마다 (인원-1)번만 보내면 되므로
+// 비트마스크 DP로 가장 많은 무리를 찾는다.
+func settle(net map[string]int) []Transfer {
+	people := make([]string, 0, len(net))
+	for p := range net {
+		people = append(people, p)
+	}
+	sort.Strings(people)
+	if len(people) > maxExactSettle {
+		return settleGroup(people, net)
+	}
+
+	n := len(people)
+	sum := make([]int, 1<<n)
+	groups := make([]int, 1<<n)
+	for mask := 1; mask < 1<<n; mask++ {
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			sum[mask] = sum[mask^(1<<i)] + net[people[i]]
+			break
+		}
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) != 0 {
+				groups[mask] = max(groups[mask], groups[mask^(1<<i)])
+			}
+		}
+		if sum[mask] == 0 {
+			groups[mask]++
+		}
+	}
+
+	// 사람을 하나씩 빼며 거꾸로 따라가면 합이 0이 되는 지점이 무리의 경계가 된다.
+	transfers := []Transfer{}
+	group := []string{}
+	for mask := 1<<n - 1; mask != 0; {
+		want := groups[mask]
+		if sum[mask] == 0 {
+			want--
+			if len(group) > 0 {
+				transfers = append(transfers, settleGroup(group, net)...)
+				group = nil
+			}
+		}
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) != 0 && groups[mask^(1<<i)] == want {
+				group = append(group, people[i])
+				mask ^= 1 << i
+				break
+			}
+		}
+	}
+	transfers = append(transfers, settleGroup(group, net)...)
+	sort.SliceStable(transfers, func(i, j int) bool { return transfers[i].Amount > transfers[j].Amount })
+	return transfers
+}
+
+// 보낼 사람과 받을 사람을 금액이 큰 순서로 맞춰 정산함
+// 잔액의 합이 0인 k명이면 최대 k-1번 보낸다.
+func settleGroup(people []string, net map[string]int) []Transfer {
+	type balance struct {
+		person string
+		amount int
+	}
+	var debtors, creditors []balance
+	for _, p := range people {
+		if net[p] < 0 {
+			debtors = append(debtors, balance{p, -net[p]})
+		} else if net[p] > 0 {
+			creditors = append(creditors, balance{p, net[p]})
+		}
+	}
+	byAmount := func(list []balance) {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].amount > list[j].amount })
+	}
+	byAmount(debtors)
+	byAmount(creditors)
+
+	transfers := []Transfer{}
+	for i, j := 0, 0; i < len(debtors) && j < len(creditors); {
+		amount := min(debtors[i].amount, creditors[j].amount)
+		transfers = append(transfers, Transfer{From: debtors[i].person, To: creditors[j].person, Amount: amount})
+		debtors[i].amount -= amount
+		creditors[j].amount -= amount
+		if debtors[i].amount == 0 {
+			i++
+		}
+		if creditors[j].amount == 0 {
+			j++
+		}
+	}
+	return transfers
+}
+
+func (d *RestaurantData) settlement() *Settlement {
+	net := d.balances()
+	balances := make([]Share, 0, len(net))
+	for p, amount := range net {
+		balances = append(balances, Share{Person: p, Amount: amount})
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		if balances[i].Amount != balances[j].Amount {
+			return balances[i].Amount > balances[j].Amount
+		}
+		return balances[i].Person < balances[j].Person
+	})
+	return &Settlement{Balances: balances, Transfers: settle(net)}
+}
+
+// 지금까지의 잔액과 정산 방법
+func (s *Service) Settlement() (*Settlement, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	return data.settlement(), nil
+}
+
+// 같이 먹은 점심의 계산서를 기록함. date가 비어 있으면 오늘이다.
+func (s *Service) AddBill(bill Bill) (*Bill, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	rest, err := data.findRestaurant(bill.Restaurant)
+	if err != nil {
+		return nil, err
+	}
+	bill.Restaurant = rest.Name
+	if bill.Date == "" {
+		bill.Date = s.now().Format(dateLayout)
+	}
+	if _, err := time.Parse(dateLayout, bill.Date); err != nil {
+		return nil, fmt.Errorf("%w: 날짜는 YYYY-MM-DD 형식이어야 합니다: %s", ErrInvalid, bill.Date)
+	}
+	if err := bill.resolve(rest); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+	data.Bills = append(data.Bills, bill)
+	if err := s.repo.Save(data); err != nil {
+		return nil, err
+	}
+	return &bill, nil
+}
+
+// 정산을 위해 보낸 돈을 기록함. date가 비어 있으면 오늘이다.
+func (s *Service) AddPayment(t Transfer) (*Transfer, error) {
+	t.From, t.To = cleanTerm(t.From), cleanTerm(t.To)
+	if t.From == "" || t.To == "" {
+		return nil, fmt.Errorf("%w: 보낸 사람(from)과 받은 사람(to)은 필수입니다", ErrInvalid)
+	}
+	if t.From == t.To {
+		return nil, fmt.Errorf("%w: 자기 자신에게 보낼 수 없습니다: %s", ErrInvalid, t.From)
+	}
+	if t.Amount <= 0 {
+		return nil, fmt.Errorf("%w: 금액은 0보다 커야 합니다: %d", ErrInvalid, t.Amount)
+	}
+	if t.Date == "" {
+		t.Date = s.now().Format(dateLayout)
+	}
+	if _, err := time.Parse(dateLayout, t.Date); err != nil {
+		return nil, fmt.Errorf("%w: 날짜는 YYYY-MM-DD 형식이어야 합니다: %s", ErrInvalid, t.Date)
+	}
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	data.Payments = append(data.Payments, t)
+	if err := s.repo.Save(data); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -0,0 +1,106 @@
+package restaurant
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBillShares_SharedAndRemainder(t *testing.T) {
+	b := Bill{Payer: "철수", Items: []BillItem{
+		{Menu: "순대국", Price: 9000, People: []string{"영희"}},
+		{Menu: "수육", Price: 25000},
+		{Menu: "소주", Price: 5000, People: []string{"영희", "민수"}},
+	}}
+	want := []Share{{"철수", 8334}, {"영희", 9000 + 8333 + 2500}, {"민수", 8333 + 2500}}
+	got := b.Shares()
+	if len(got) != len(want) {
+		t.Fatalf("몫 %+v, 기대값 %+v", got, want)
+	}
+	sum := 0
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("몫 %+v, 기대값 %+v", got, want)
+		}
+		sum += got[i].Amount
+	}
+	if sum != b.Total() {
+		t.Fatalf("몫의 합 %d이 합계 %d와 다름", sum, b.Total())
+	}
+}
+
+func TestSettle_MinimizesTransfers(t *testing.T) {
+	// 큰 금액끼리 맞추면 4번이지만 {B,E}와 {A,C,D}로 나누면 3번이면 된다.
+	net := map[string]int{"A": 7000, "B": 3000, "C": -5000, "D": -2000, "E": -3000}
+	transfers := settle(net)
+	if len(transfers) != 3 {
+		t.Fatalf("송금 %d번, 기대값 3번: %+v", len(transfers), transfers)
+	}
+	for _, tr := range transfers {
+		net[tr.From] += tr.Amount
+		net[tr.To] -= tr.Amount
+	}
+	for p, amount := range net {
+		if amount != 0 {
+			t.Fatalf("정산 후 %s의 잔액이 %d원", p, amount)
+		}
+	}
+	if got := settle(map[string]int{}); len(got) != 0 {
+		t.Fatalf("잔액이 없는데 송금이 있음: %+v", got)
+	}
+}
+
+func TestServiceAddBill_MenuPrices(t *testing.T) {
+	s := newTestService(t, orderTestData(), time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local))
+	bill, err := s.AddBill(Bill{Restaurant: "국밥집", Payer: " 철수 ", Items: []BillItem{
+		{Menu: "순대국", People: []string{"철수"}},
+		{Menu: "순대국", People: []string{"영희"}},
+		{Menu: "수육", People: []string{"철수", "영희", "민수"}},
+	}})
+	if err != nil {
+		t.Fatalf("계산서 기록 실패: %v", err)
+	}
+	if bill.Date != "2026-03-10" || bill.Payer != "철수" || bill.Total() != 43000 {
+		t.Fatalf("계산서가 다름: %+v", bill)
+	}
+
+	got, err := s.Settlement()
+	if err != nil {
+		t.Fatalf("정산 조회 실패: %v", err)
+	}
+	if len(got.Transfers) != 2 || got.Balances[0] != (Share{"철수", 43000 - 9000 - 8334}) {
+		t.Fatalf("정산이 다름: %+v", got)
+	}
+
+	if _, err := s.AddPayment(Transfer{From: "영희", To: "철수", Amount: 9000 + 8333}); err != nil {
+		t.Fatalf("송금 기록 실패: %v", err)
+	}
+	got, err = s.Settlement()
+	if err != nil {
+		t.Fatalf("정산 조회 실패: %v", err)
+	}
+	if len(got.Transfers) != 1 || got.Transfers[0] != (Transfer{From: "민수", To: "철수", Amount: 8333}) {
+		t.Fatalf("송금 후 정산이 다름: %+v", got.Transfers)
+	}
+}
+
+func TestServiceAddBill_Invalid(t *testing.T) {
+	s := newTestService(t, orderTestData(), time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local))
+	cases := []Bill{
+		{Restaurant: "국밥집", Items: []BillItem{{Menu: "순대국"}}},
+		{Restaurant: "국밥집", Payer: "철수"},
+		{Restaurant: "국밥집", Payer: "철수", Items: []BillItem{{Menu: "없는 메뉴"}}},
+		{Restaurant: "국밥집", Payer: "철수", Date: "3/10", Items: []BillItem{{Menu: "순대국"}}},
+	}
+	for _, bill := range cases {
+		if _, err := s.AddBill(bill); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%+v: ErrInvalid가 아님: %v", bill, err)
+		}
+	}
+	if _, err := s.AddBill(Bill{Restaurant: "없는 식당", Payer: "철수"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("없는 식당에 ErrNotFound가 아님: %v", err)
+	}
+	if _, err := s.AddPayment(Transfer{From: "철수", To: "철수", Amount: 1000}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("자신에게 보낸 송금에 ErrInvalid가 아님: %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+package restaurant
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arch-spatula/jmc/internal/hangul"
+)
+
+// 상위/하위 관계와 별칭이 있는 분류 항목. 지역 분류와 카테고리 분류에 쓴다.
+// 이름과 별칭은 분류 전체에서 겹치면 안 된다.
+type Term struct {
+	Name     string   `json:"name"`
+	Aliases  []string `json:"aliases"`
+	Children []Term   `json:"children"`
+}
+
+// 앞뒤 공백과 겹친 공백을 정리하고 한글을 NFC로 합친 이름
+func cleanTerm(s string) string {
+	return strings.Join(strings.Fields(hangul.NFC(s)), " ")
+}
+
+// 이름과 별칭을 비교할 때 쓰는 키. 공백, 대소문자, 한글 정규화 차이를 무시한다.
+func termKey(s string) string {
+	return strings.ToLower(cleanTerm(s))
+}
+
+func validateTerms(terms []Term, seen map[string]bool) error {
+	for _, term := range terms {
+		if termKey(term.Name) == "" {
+			return fmt.Errorf("name은 필수입니다")
+		}
+		for _, name := range append([]string{term.Name}, term.Aliases...) {
+			key := termKey(name)
+			if seen[key] {
+				return fmt.Errorf("이름이나 별칭이 중복됩니다: %s", name)
+			}
+			seen[key] = true
+		}
+		if err := validateTerms(term.Children, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 이름(별칭 포함)의 키 → 최상위 항목부터 그 항목까지의 이름
+type termIndex map[string][]string
+
+func buildTermIndex(terms []Term) termIndex {
+	idx := termIndex{}
+	var walk func(terms []Term, parent []string)
+	walk = func(terms []Term, parent []string) {
+		for _, term := range terms {
+			path := append(append([]string{}, parent...), term.Name)
+			for _, name := range append([]string{term.Name}, term.Aliases...) {
+				idx[termKey(name)] = path
+			}
+			walk(term.Children, path)
+		}
+	}
+	walk(terms, nil)
+	return idx
+}
+
+// 분류에 있는 이름. 별칭이면 원래 이름으로 바꾸고, 분류에 없으면 공백과 한글만 정리한다.
+func (idx termIndex) canonical(s string) string {
+	if path, ok := idx[termKey(s)]; ok {
+		return path[len(path)-1]
+	}
+	return cleanTerm(s)
+}
+
+// value가 parent이거나 그 하위 항목인지 확인함
+func (idx termIndex) within(value, parent string) bool {
+	target := idx.canonical(parent)
+	path, ok := idx[termKey(value)]
+	if !ok {
+		return idx.canonical(value) == target
+	}
+	return contains(path, target)
+}
+
+// values 중 하나라도 parents 중 하나에 속하는지 확인함
+func (idx termIndex) matchesAny(values []string, parents []string) bool {
+	for _, value := range values {
+		for _, parent := range parents {
+			if idx.within(value, parent) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// 분류에 맞춰 정리한 값. 빈 값과 중복은 뺀다.
+func (idx termIndex) normalize(values []string) []string {
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		name := idx.canonical(value)
+		if name != "" && !contains(normalized, name) {
+			normalized = append(normalized, name)
+		}
+	}
+	return normalized
+}
+
+// 선택기와 자동완성에 보여줄 분류 항목과 그 항목(하위 항목 포함)이 붙은 식당 수
+type TermNode struct {
+	Name     string     `json:"name"`
+	Aliases  []string   `json:"aliases"`
+	Count    int        `json:"count"`
+	Children []TermNode `json:"children"`
+}
+
+// 분류 항목마다 식당 수를 셈
+// values는 식당에서 셀 값(위치나 카테고리)을 꺼낸다.
+func countTerms(terms []Term, idx termIndex, restaurants []Restaurant, values func(*Restaurant) []string) []TermNode {
+	nodes := make([]TermNode, 0, len(terms))
+	for _, term := range terms {
+		count := 0
+		for i := range restaurants {
+			if idx.matchesAny(values(&restaurants[i]), []string{term.Name}) {
+				count++
+			}
+		}
+		aliases := term.Aliases
+		if aliases == nil {
+			aliases = []string{}
+		}
+		nodes = append(nodes, TermNode{
+			Name:     term.Name,
+			Aliases:  aliases,
+			Count:    count,
+			Children: countTerms(term.Children, idx, restaurants, values),
+		})
+	}
+	return nodes
+}
+
+// 분류에 없는 값과 그 값이 붙은 식당 수. 이름 순서로 정렬한다.
+// 대소문자나 공백만 다른 값은 먼저 나온 표기로 함께 센다.
+func otherTerms(idx termIndex, restaurants []Restaurant, values func(*Restaurant) []string) []TermNode {
+	counts := map[string]int{}
+	names := map[string]string{}
+	for i := range restaurants {
+		seen := []string{}
+		for _, value := range values(&restaurants[i]) {
+			key := termKey(value)
+			if _, ok := idx[key]; ok || key == "" || contains(seen, key) {
+				continue
+			}
+			seen = append(seen, key)
+			if _, ok := names[key]; !ok {
+				names[key] = cleanTerm(value)
+			}
+			counts[key]++
+		}
+	}
+	others := make([]TermNode, 0, len(counts))
+	for key, n := range counts {
+		others = append(others, TermNode{Name: names[key], Aliases: []string{}, Count: n, Children: []TermNode{}})
+	}
+	sort.Slice(others, func(i, j int) bool {
+		return others[i].Name < others[j].Name
+	})
+	return others
+}
@@ -0,0 +1,84 @@
+package restaurant
+
+import "testing"
+
+func testTerms() []Term {
+	return []Term{
+		{Name: "서울", Children: []Term{
+			{Name: "강남구", Aliases: []string{"Gangnam"}, Children: []Term{{Name: "역삼동"}}},
+		}},
+		{Name: "경기"},
+	}
+}
+
+func TestTermIndex_Canonical(t *testing.T) {
+	idx := buildTermIndex(testTerms())
+	cases := []struct{ in, want string }{
+		{"강남구", "강남구"},
+		{" GANGNAM ", "강남구"},
+		{"역삼동", "역삼동"},
+		// 분류에 없는 값은 공백만 정리한다.
+		{"  판교   역 ", "판교 역"},
+	}
+	for _, tc := range cases {
+		if got := idx.canonical(tc.in); got != tc.want {
+			t.Errorf("canonical(%q) = %q, 기대값 %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestTermIndex_Within(t *testing.T) {
+	idx := buildTermIndex(testTerms())
+	cases := []struct {
+		value, parent string
+		want          bool
+	}{
+		{"역삼동", "서울", true},
+		{"역삼동", "gangnam", true},
+		{"강남구", "역삼동", false},
+		{"경기", "서울", false},
+		{"판교", "판교", true},
+		{"판교", "경기", false},
+	}
+	for _, tc := range cases {
+		if got := idx.within(tc.value, tc.parent); got != tc.want {
+			t.Errorf("within(%q, %q) = %v, 기대값 %v", tc.value, tc.parent, got, tc.want)
+		}
+	}
+}
+
+func TestTermIndex_Normalize(t *testing.T) {
+	idx := buildTermIndex(testTerms())
+	got := idx.normalize([]string{"gangnam", "강남구", " ", "판교"})
+	if want := []string{"강남구", "판교"}; !sameNames(got, want) {
+		t.Fatalf("%q, 기대값 %q", got, want)
+	}
+}
+
+func TestValidateTerms_DuplicateAcrossLevels(t *testing.T) {
+	terms := []Term{{Name: "서울", Children: []Term{{Name: "강남"}}}, {Name: "경기", Aliases: []string{" 강남"}}}
+	if err := validateTerms(terms, map[string]bool{}); err == nil {
+		t.Fatal("다른 항목의 이름과 겹치는 별칭은 오류여야 함")
+	}
+	if err := validateTerms([]Term{{Name: " "}}, map[string]bool{}); err == nil {
+		t.Fatal("이름이 빈 항목은 오류여야 함")
+	}
+	if err := validateTerms(testTerms(), map[string]bool{}); err != nil {
+		t.Fatalf("올바른 분류인데 오류: %v", err)
+	}
+}
+
+func TestOtherTerms_MergesSpellings(t *testing.T) {
+	idx := buildTermIndex(testTerms())
+	list := testRestaurants("a", "b", "c")
+	list[0].Locations = []string{"판교", "강남구"}
+	list[1].Locations = []string{" 판교 ", "판교"}
+	list[2].Locations = []string{"Pangyo", "pangyo"}
+	got := otherTerms(idx, list, restaurantLocations)
+	if len(got) != 2 {
+		t.Fatalf("분류에 없는 값 2개를 기대했지만 %+v", got)
+	}
+	if got[0].Name != "Pangyo" || got[0].Count != 1 || got[1].Name != "판교" || got[1].Count != 2 {
+		t.Fatalf("잘못된 집계: %+v", got)
+	}
+}
@@ -0,0 +1,108 @@
+package restaurant
+
+import "fmt"
+
+// 식당 이름이 바뀌면 방문 기록과 계산서도 함께 옮김
+func (d *RestaurantData) renameVisits(from, to string) {
+	if from == to {
+		return
+	}
+	for i := range d.Visits {
+		if d.Visits[i].Restaurant == from {
+			d.Visits[i].Restaurant = to
+		}
+	}
+	for i := range d.Bills {
+		if d.Bills[i].Restaurant == from {
+			d.Bills[i].Restaurant = to
+		}
+	}
+}
+
+// 지운 식당의 방문 기록을 지움
+// 남겨 두면 같은 이름으로 새로 등록한 식당이 옛 방문 기록을 물려받는다.
+// 계산서는 정산이 끝나지 않았을 수 있으므로 그대로 둔다.
+func (d *RestaurantData) removeVisits(name string) {
+	kept := d.Visits[:0]
+	for _, v := range d.Visits {
+		if v.Restaurant != name {
+			kept = append(kept, v)
+		}
+	}
+	d.Visits = kept
+}
+
+func (d *RestaurantData) findRestaurant(name string) (*Restaurant, error) {
+	for i := range d.Restaurants {
+		if d.Restaurants[i].Name == name {
+			return &d.Restaurants[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// 추천받은 식당에 다녀왔음을 기록함
+// name이 비어 있으면 오늘 마지막으로 추천받은 식당을 사용한다.
+func (s *Service) Accept(name string, visit Visit) (*Visit, error) {
+	data, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	today := s.now().Format(dateLayout)
+	if name == "" {
+		name, err = data.Recommend.lastPick(today)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rest, err := data.findRestaurant(name)
+	if err != nil {
+		return nil, err
+	}
+
+	visit.Restaurant = rest.Name
+	if visit.Date == "" {
+		visit.Date = today
+	}
+	if visit.Menus == nil {
+		visit.Menus = []string{}
+	}
+	if err := visit.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+	// 다시 실행하거나 요청을 재시도해도 지출과 쿨다운이 두 번 계산되지 않게 함
+	for _, v := range data.Visits {
+		if v.Restaurant == visit.Restaurant && v.Date == visit.Date {
+			return nil, fmt.Errorf("%w: 이미 기록한 방문입니다: %s (%s)", ErrInvalid, visit.Restaurant, visit.Date)
+		}
+	}
+
+	rest.Visited = true
+	for i := range rest.Menus {
+		if contains(visit.Menus, rest.Menus[i].Name) {
+			rest.Menus[i].Visited = true
+		}
+	}
+	data.Visits = append(data.Visits, visit)
+	// 오늘 점심을 기록했으면 직전 추천은 다 쓴 것으로 봄
+	if visit.Date == today {
+		data.Recommend.Last = []string{}
+	}
+
+	if err := s.repo.Save(data); err != nil {
+		return nil, err
+	}
+	return &visit, nil
+}
+
+// 오늘 추천받은 식당이 하나뿐일 때 그 이름을 반환함
+func (st *RecommendState) lastPick(today string) (string, error) {
+	if st.Date != today || len(st.Last) == 0 {
+		return "", fmt.Errorf("%w: 오늘 추천받은 식당이 없습니다", ErrInvalid)
+	}
+	if len(st.Last) > 1 {
+		return "", fmt.Errorf("%w: 추천받은 식당이 여러 곳입니다. 식당 이름을 지정해주세요: %v", ErrInvalid, st.Last)
+	}
+	return st.Last[0], nil
+}
@@ -0,0 +1,137 @@
+package restaurant
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestServiceAccept_UsesLastRecommendation(t *testing.T) {
+	list := testRestaurants("김밥천국")
+	list[0].Menus = []Menu{{Name: "라볶이", Price: 6000}}
+	s := newTestService(t, &RestaurantData{Restaurants: list}, time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+
+	if _, err := s.Recommend(RecommendOptions{Count: 1}); err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	visit, err := s.Accept("", Visit{Menus: []string{"라볶이"}, Spend: 6000, Rating: 4})
+	if err != nil {
+		t.Fatalf("방문 기록 실패: %v", err)
+	}
+	if visit.Restaurant != "김밥천국" || visit.Date != "2026-03-02" {
+		t.Fatalf("잘못된 방문 기록: %+v", visit)
+	}
+
+	data, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("데이터 읽기 실패: %v", err)
+	}
+	if len(data.Visits) != 1 {
+		t.Fatalf("방문 기록 1개를 기대했지만 %d개", len(data.Visits))
+	}
+	if !data.Restaurants[0].Visited || !data.Restaurants[0].Menus[0].Visited {
+		t.Fatal("식당과 주문한 메뉴가 방문으로 표시되지 않음")
+	}
+}
+
+func TestServiceAccept_RejectsSameDayVisit(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("김밥천국")}, time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local))
+	if _, err := s.Recommend(RecommendOptions{Count: 1}); err != nil {
+		t.Fatalf("추천 실패: %v", err)
+	}
+	if _, err := s.Accept("", Visit{Spend: 6000}); err != nil {
+		t.Fatalf("방문 기록 실패: %v", err)
+	}
+	// 직전 추천은 기록과 함께 비워지므로 이름 없이 다시 기록할 수 없음
+	if _, err := s.Accept("", Visit{Spend: 6000}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("직전 추천을 비웠으면 ErrInvalid를 기대했지만 %v", err)
+	}
+	if _, err := s.Accept("김밥천국", Visit{Spend: 6000}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("같은 날 같은 식당은 ErrInvalid를 기대했지만 %v", err)
+	}
+	if _, err := s.Accept("김밥천국", Visit{Date: "2026-03-03"}); err != nil {
+		t.Fatalf("다른 날 방문 기록 실패: %v", err)
+	}
+	data, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("데이터 읽기 실패: %v", err)
+	}
+	if len(data.Visits) != 2 {
+		t.Fatalf("방문 기록 2개를 기대했지만 %+v", data.Visits)
+	}
+}
+
+func TestServiceAccept_NoRecommendation(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("김밥천국")}, time.Time{})
+	if _, err := s.Accept("", Visit{}); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("추천이 없으면 ErrInvalid를 기대했지만 %v", err)
+	}
+	if _, err := s.Accept("없는식당", Visit{}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("없는 식당이면 ErrNotFound를 기대했지만 %v", err)
+	}
+}
+
+func TestRepositoryUpdate_RenamesVisits(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("김밥천국")}, time.Time{})
+	if _, err := s.Accept("김밥천국", Visit{}); err != nil {
+		t.Fatalf("방문 기록 실패: %v", err)
+	}
+	renamed := testRestaurants("김밥천국 역삼점")[0]
+	if err := s.Update("김밥천국", renamed); err != nil {
+		t.Fatalf("수정 실패: %v", err)
+	}
+	data, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("데이터 읽기 실패: %v", err)
+	}
+	if data.Visits[0].Restaurant != "김밥천국 역삼점" {
+		t.Fatalf("방문 기록의 식당 이름이 바뀌지 않음: %s", data.Visits[0].Restaurant)
+	}
+}
+
+func TestRepositoryDelete_RemovesVisits(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("김밥천국", "국밥집")}, time.Time{})
+	for _, name := range []string{"김밥천국", "국밥집"} {
+		if _, err := s.Accept(name, Visit{}); err != nil {
+			t.Fatalf("방문 기록 실패: %v", err)
+		}
+	}
+	if err := s.Delete("김밥천국"); err != nil {
+		t.Fatalf("삭제 실패: %v", err)
+	}
+	data, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("데이터 읽기 실패: %v", err)
+	}
+	if len(data.Visits) != 1 || data.Visits[0].Restaurant != "국밥집" {
+		t.Fatalf("지운 식당의 방문 기록만 사라져야 함: %+v", data.Visits)
+	}
+}
+
+func TestRepositorySaveBatch_RenamesAndDeletesVisits(t *testing.T) {
+	s := newTestService(t, &RestaurantData{Restaurants: testRestaurants("김밥천국", "국밥집")}, time.Time{})
+	for _, name := range []string{"김밥천국", "국밥집"} {
+		if _, err := s.Accept(name, Visit{}); err != nil {
+			t.Fatalf("방문 기록 실패: %v", err)
+		}
+	}
+	renamed := testRestaurants("김밥천국 역삼점")[0]
+	_, err := s.SaveBatch(SaveRequest{
+		Update:  []Restaurant{renamed},
+		Delete:  []string{"국밥집"},
+		Renames: map[string]string{"김밥천국": "김밥천국 역삼점"},
+	})
+	if err != nil {
+		t.Fatalf("일괄 저장 실패: %v", err)
+	}
+	data, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("데이터 읽기 실패: %v", err)
+	}
+	if len(data.Restaurants) != 1 || data.Restaurants[0].Name != "김밥천국 역삼점" {
+		t.Fatalf("이름을 바꾼 식당 하나만 남아야 함: %+v", data.Restaurants)
+	}
+	if len(data.Visits) != 1 || data.Visits[0].Restaurant != "김밥천국 역삼점" {
+		t.Fatalf("방문 기록이 새 이름으로 옮겨지고 지운 식당 기록은 사라져야 함: %+v", data.Visits)
+	}
+}
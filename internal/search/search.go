@@ -0,0 +1,249 @@
+// search 패키지는 한글 이름에 맞춘 검색 색인이다.
+// 부분 문자열 외에 초성(ㄱㅂㅊㄱ → 김밥천국), 자모 단위 오타 허용, 로마자(gimbap → 김밥) 검색을 지원한다.
+package search
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/arch-spatula/jmc/internal/hangul"
+)
+
+// 일치 종류. 위에 있을수록 점수가 높다.
+const (
+	KindExact    = "exact"
+	KindPrefix   = "prefix"
+	KindContains = "contains"
+	KindChoseong = "choseong"
+	KindRoman    = "roman"
+	KindFuzzy    = "fuzzy"
+)
+
+var kindScores = map[string]float64{
+	KindExact:    1,
+	KindPrefix:   0.9,
+	KindContains: 0.8,
+	KindChoseong: 0.7,
+	KindRoman:    0.6,
+	KindFuzzy:    0.5,
+}
+
+// 색인할 문서. ID는 검색 결과에 그대로 돌려준다.
+type Document struct {
+	ID     string
+	Fields []Field
+}
+
+// 문서의 필드 하나. Weight가 클수록 이 필드에서 맞았을 때 점수가 높다.
+type Field struct {
+	Name   string
+	Text   string
+	Weight float64
+}
+
+// 검색어가 맞은 필드
+type Match struct {
+	Field string `json:"field"`
+	Text  string `json:"text"`
+	Kind  string `json:"kind"`
+}
+
+type Result struct {
+	ID      string  `json:"id"`
+	Score   float64 `json:"score"`
+	Matches []Match `json:"matches"`
+}
+
+// 검색에 쓰는 형태로 미리 바꿔 둔 필드
+type indexedField struct {
+	Field
+	text     string
+	jamo     []rune
+	choseong string
+	roman    string
+}
+
+type indexedDocument struct {
+	id     string
+	fields []indexedField
+}
+
+type Index struct {
+	docs []indexedDocument
+}
+
+// 공백을 하나로 줄이고 소문자로 바꿈
+func normalize(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(hangul.NFC(s)), " "))
+}
+
+// 로마자 비교용. 공백과 구두점을 뺀다.
+func compact(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '\'', '.', ',':
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func NewIndex(docs []Document) *Index {
+	idx := &Index{docs: make([]indexedDocument, 0, len(docs))}
+	for _, doc := range docs {
+		indexed := indexedDocument{id: doc.ID}
+		for _, f := range doc.Fields {
+			text := normalize(f.Text)
+			if text == "" {
+				continue
+			}
+			indexed.fields = append(indexed.fields, indexedField{
+				Field:    f,
+				text:     text,
+				jamo:     []rune(hangul.Decompose(text)),
+				choseong: compact(hangul.Choseong(text)),
+				roman:    compact(hangul.Romanize(text)),
+			})
+		}
+		idx.docs = append(idx.docs, indexed)
+	}
+	return idx
+}
+
+// 검색어를 공백으로 나눈 낱말이 모두 맞는 문서를 점수가 높은 순서로 limit개까지 반환함
+// limit이 0 이하면 모두 반환한다.
+func (idx *Index) Search(query string, limit int) []Result {
+	terms := strings.Fields(normalize(query))
+	if len(terms) == 0 {
+		return []Result{}
+	}
+
+	results := []Result{}
+	for _, doc := range idx.docs {
+		result := Result{ID: doc.id, Matches: []Match{}}
+		matched := true
+		for _, term := range terms {
+			score, matches := matchTerm(doc.fields, term)
+			if score == 0 {
+				matched = false
+				break
+			}
+			result.Score += score
+			result.Matches = append(result.Matches, matches...)
+		}
+		if matched {
+			results = append(results, result)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// 낱말 하나의 점수. 가장 잘 맞은 필드 점수에 다른 필드에서 맞은 점수를 조금 더한다.
+func matchTerm(fields []indexedField, term string) (float64, []Match) {
+	best, rest := 0.0, 0.0
+	matches := []Match{}
+	for _, f := range fields {
+		kind, similarity := matchField(f, term)
+		if kind == "" {
+			continue
+		}
+		score := f.Weight * kindScores[kind] * similarity
+		if score > best {
+			rest += best
+			best = score
+		} else {
+			rest += score
+		}
+		matches = append(matches, Match{Field: f.Name, Text: f.Text, Kind: kind})
+	}
+	return best + rest*0.1, matches
+}
+
+// 필드에 낱말이 맞는 종류와 유사도(0~1)
+func matchField(f indexedField, term string) (string, float64) {
+	switch {
+	case f.text == term:
+		return KindExact, 1
+	case strings.HasPrefix(f.text, term):
+		return KindPrefix, 1
+	case strings.Contains(f.text, term):
+		return KindContains, 1
+	}
+	if hangul.IsChoseongQuery(term) {
+		if strings.Contains(f.choseong, compact(term)) {
+			return KindChoseong, 1
+		}
+		return "", 0
+	}
+	if isLatin(term) {
+		roman := compact(term)
+		if strings.Contains(f.roman, roman) {
+			return KindRoman, 1
+		}
+		if similarity, ok := fuzzy([]rune(roman), []rune(f.roman)); ok {
+			return KindFuzzy, similarity
+		}
+		return "", 0
+	}
+	if similarity, ok := fuzzy([]rune(hangul.Decompose(term)), f.jamo); ok {
+		return KindFuzzy, similarity
+	}
+	return "", 0
+}
+
+func isLatin(s string) bool {
+	found := false
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z':
+			found = true
+		case r >= '0' && r <= '9', r == ' ', r == '-', r == '\'':
+		default:
+			return false
+		}
+	}
+	return found
+}
+
+// 오타를 허용하는 부분 일치
+// pattern이 text의 어느 부분과 편집 거리 허용치 안에 들면 유사도를 반환한다.
+// 허용치는 패턴의 자모(로마자 표기면 글자) 4개마다 1이므로, 자모 4개 미만인 짧은 패턴은 오타를 허용하지 않는다.
+func fuzzy(pattern, text []rune) (float64, bool) {
+	allowed := len(pattern) / 4
+	if allowed == 0 || len(text) == 0 {
+		return 0, false
+	}
+	distance := substringDistance(pattern, text)
+	if distance > allowed {
+		return 0, false
+	}
+	return 1 - float64(distance)/float64(len(pattern)), true
+}
+
+// pattern과 text의 부분 문자열 사이의 최소 편집 거리 (Sellers 알고리즘)
+func substringDistance(pattern, text []rune) int {
+	prev := make([]int, len(text)+1)
+	cur := make([]int, len(text)+1)
+	for i := 1; i <= len(pattern); i++ {
+		cur[0] = i
+		for j := 1; j <= len(text); j++ {
+			cost := 1
+			if pattern[i-1] == text[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j-1]+cost, prev[j]+1, cur[j-1]+1)
+		}
+		prev, cur = cur, prev
+	}
+	best := prev[0]
+	for _, d := range prev {
+		best = min(best, d)
+	}
+	return best
+}
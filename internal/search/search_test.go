@@ -0,0 +1,65 @@
+package search
+
+import "testing"
+
+func testIndex() *Index {
+	return NewIndex([]Document{
+		{ID: "김밥천국", Fields: []Field{{Name: "name", Text: "김밥천국", Weight: 3}, {Name: "menu", Text: "참치김밥", Weight: 2}}},
+		{ID: "국밥집", Fields: []Field{{Name: "name", Text: "순대국밥집", Weight: 3}, {Name: "tag", Text: "한식", Weight: 1.5}}},
+		{ID: "떡볶이", Fields: []Field{{Name: "name", Text: "신당동 떡볶이", Weight: 3}, {Name: "description", Text: "김밥도 팝니다", Weight: 1}}},
+	})
+}
+
+func ids(results []Result) []string {
+	list := make([]string, 0, len(results))
+	for _, r := range results {
+		list = append(list, r.ID)
+	}
+	return list
+}
+
+func TestSearch_SubstringRanksNameFirst(t *testing.T) {
+	got := testIndex().Search("김밥", 0)
+	if len(got) != 2 || got[0].ID != "김밥천국" || got[1].ID != "떡볶이" {
+		t.Fatalf("이름에 김밥이 있는 식당이 먼저 와야 함: %v", ids(got))
+	}
+}
+
+func TestSearch_Choseong(t *testing.T) {
+	got := testIndex().Search("ㄱㅂㅊㄱ", 0)
+	if len(got) != 1 || got[0].ID != "김밥천국" || got[0].Matches[0].Kind != KindChoseong {
+		t.Fatalf("초성으로 김밥천국을 기대했지만 %+v", got)
+	}
+}
+
+func TestSearch_FuzzyJamo(t *testing.T) {
+	// 받침 하나를 잘못 친 검색어
+	got := testIndex().Search("김밥천극", 0)
+	if len(got) != 1 || got[0].ID != "김밥천국" || got[0].Matches[0].Kind != KindFuzzy {
+		t.Fatalf("오타를 허용해 김밥천국을 기대했지만 %+v", got)
+	}
+}
+
+func TestSearch_Romanization(t *testing.T) {
+	got := testIndex().Search("tteokbokki", 0)
+	if len(got) != 1 || got[0].ID != "떡볶이" {
+		t.Fatalf("로마자로 떡볶이를 기대했지만 %v", ids(got))
+	}
+	// 다른 표기도 오타로 허용함
+	got = testIndex().Search("kimbap cheonguk", 0)
+	if len(got) != 1 || got[0].ID != "김밥천국" {
+		t.Fatalf("kimbap으로 김밥천국을 기대했지만 %v", ids(got))
+	}
+}
+
+func TestSearch_AllTermsMustMatch(t *testing.T) {
+	if got := testIndex().Search("국밥 떡볶이", 0); len(got) != 0 {
+		t.Fatalf("모든 낱말이 맞는 문서가 없어야 함: %v", ids(got))
+	}
+	if got := testIndex().Search("국밥 한식", 0); len(got) != 1 || got[0].ID != "국밥집" {
+		t.Fatalf("국밥집을 기대했지만 %v", ids(got))
+	}
+	if got := testIndex().Search("  ", 0); len(got) != 0 {
+		t.Fatalf("빈 검색어에 결과가 있음: %v", ids(got))
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/arch-spatula/jmc/cmd"
 )
@@ -12,8 +13,7 @@ const version = "0.1.0"
 func main() {
 	// 인자가 없으면 식당을 출력함
 	if len(os.Args) == 1 {
-		cmd.Recommend()
-		os.Exit(0)
+		run(cmd.Recommend(nil))
 	}
 
 	switch os.Args[1] {
@@ -42,6 +42,78 @@ func main() {
 		fmt.Println("개발 예정")
 		os.Exit(0)
 
+	//
+	case "-r":
+		fallthrough
+	case "reroll":
+		run(cmd.Reroll(os.Args[2:]))
+
+	//
+	case "list":
+		run(cmd.List(os.Args[2:]))
+
+	//
+	case "search":
+		run(cmd.Search(os.Args[2:]))
+
+	//
+	case "tags":
+		run(cmd.Tags(os.Args[2:]))
+
+	//
+	case "menu":
+		run(cmd.Menu(os.Args[2:]))
+
+	//
+	case "budget":
+		run(cmd.Budget(os.Args[2:]))
+
+	//
+	case "split":
+		run(cmd.Split(os.Args[2:]))
+
+	//
+	case "profile":
+		run(cmd.Profile(os.Args[2:]))
+
+	//
+	case "add":
+		run(cmd.Add(os.Args[2:]))
+
+	//
+	case "import":
+		run(cmd.Import(os.Args[2:]))
+
+	//
+	case "export":
+		run(cmd.Export(os.Args[2:]))
+
+	//
+	case "doctor":
+		run(cmd.Doctor(os.Args[2:]))
+
+	//
+	case "today":
+		run(cmd.Today(os.Args[2:]))
+
+	//
+	case "plan":
+		run(cmd.Plan(os.Args[2:]))
+
+	//
+	case "holiday":
+		run(cmd.Holiday(os.Args[2:]))
+
+	//
+	case "simulate":
+		run(cmd.Simulate(os.Args[2:]))
+
+	//
+	case "go":
+		fallthrough
+	case "accept":
+		run(cmd.Accept(os.Args[2:]))
+
 	//
 	case "init":
 		cmd.Init()
@@ -54,7 +126,20 @@ func main() {
 
 	//
 	default:
+		// 플래그만 주어지면 추천 옵션으로 취급함 (예: jmc -n 3)
+		if strings.HasPrefix(os.Args[1], "-") {
+			run(cmd.Recommend(os.Args[1:]))
+		}
 		fmt.Fprintf(os.Stderr, "알 수 없는 명령어: %s\n", os.Args[1])
 		os.Exit(1)
 	}
 }
+
+// 커맨드 실행 결과에 따라 종료함
+func run(err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
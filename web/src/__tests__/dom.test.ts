@@ -104,9 +104,9 @@ describe("createEmptyRow", () => {
     expect(tr.classList.contains("restaurant-row")).toBe(true);
   });
 
-  it("9개의 td를 포함한다", () => {
+  it("10개의 td를 포함한다", () => {
     const tr = createEmptyRow();
-    expect(tr.querySelectorAll("td").length).toBe(9);
+    expect(tr.querySelectorAll("td").length).toBe(10);
   });
 
   it("rating select 드롭다운을 포함한다", () => {
@@ -164,9 +164,9 @@ describe("createMenuRow", () => {
     expect(tr.dataset.status).toBe("new-menu");
   });
 
-  it("9개의 td를 포함한다", () => {
+  it("10개의 td를 포함한다", () => {
     const tr = createMenuRow();
-    expect(tr.querySelectorAll("td").length).toBe(9);
+    expect(tr.querySelectorAll("td").length).toBe(10);
   });
 
   it("메뉴명, 가격, 소감 필드를 포함한다", () => {
@@ -654,6 +654,25 @@ describe("collectPayload", () => {
     expect(payload.update[0].description).toBe("변경됨");
   });
 
+  it("이름을 바꾼 updated 행은 renames에 원래 이름을 담는다", () => {
+    const renamed = makeRow({
+      status: "updated",
+      name: "새이름",
+      originalName: "원래이름",
+    });
+    const same = makeRow({
+      status: "updated",
+      name: "그대로",
+      originalName: "그대로",
+    });
+    const payload = collectPayload(
+      makeTbody([{ row: renamed }, { row: same }]),
+    );
+
+    expect(payload.update).toHaveLength(2);
+    expect(payload.renames).toEqual({ 원래이름: "새이름" });
+  });
+
   it("deleted 행은 originalName으로 수집한다", () => {
     const tr = makeRow({
       status: "deleted",
@@ -0,0 +1,32 @@
+import { describe, it, expect } from "vitest";
+import { formatExplain } from "../explain";
+
+describe("formatExplain", () => {
+  it("기본 점수를 제외한 요인을 부호와 함께 나열한다", () => {
+    const text = formatExplain({
+      mode: "일상",
+      score: 3.5,
+      probability: 0.25,
+      factors: [
+        { name: "base", value: 1, detail: "기본 점수" },
+        { name: "rating", value: 4.5, detail: "평점 4.5" },
+        { name: "cooldown", value: -2, detail: "2일 전 방문 (쿨다운 7일)" },
+      ],
+    });
+
+    expect(text).toBe(
+      "[일상] 점수 3.5, 확률 25.0%: 평점 4.5 (+4.5), 2일 전 방문 (쿨다운 7일) (-2.0)",
+    );
+  });
+
+  it("요인이 없으면 점수와 확률만 보여준다", () => {
+    const text = formatExplain({
+      mode: "탐방",
+      score: 1,
+      probability: 1,
+      factors: [{ name: "base", value: 1, detail: "기본 점수" }],
+    });
+
+    expect(text).toBe("[탐방] 점수 1.0, 확률 100.0%");
+  });
+});
@@ -1,10 +1,10 @@
-import type { Restaurant, SavePayload } from "./types";
+import type { Recommendation, SavePayload } from "./types";
 
 export type Fetcher = typeof fetch;
 
 export async function fetchRecommend(
   fetcher: Fetcher = fetch
-): Promise<Restaurant | null> {
+): Promise<Recommendation | null> {
   const response = await fetcher("/api/restaurants/recommend");
   if (!response.ok) {
     const text = await response.text();
@@ -1,18 +1,20 @@
 import type { Restaurant, Menu, SavePayload } from "./types";
 import { buildRatingSelect } from "./rating";
 
+// 카테고리 입력은 서버가 렌더링한 #category-options로 자동완성한다.
 function buildTagCell(
   field: string,
   tags: string[],
   placeholder: string,
 ): string {
+  const list = field === "categories" ? ' list="category-options"' : "";
   const tagsHtml = tags
     .map(
       (c) =>
         `<span class="tag" data-tag="${escapeTagAttr(c)}">${escapeTagText(c)} <button type="button" class="tag-remove" aria-label="태그 삭제">×</button></span>`,
     )
     .join("");
-  return `<td data-field="${field}" class="tag-cell"><div class="tag-container">${tagsHtml}<input type="text" class="tag-input" placeholder="${placeholder}" maxlength="50"></div></td>`;
+  return `<td data-field="${field}" class="tag-cell"><div class="tag-container">${tagsHtml}<input type="text" class="tag-input" placeholder="${placeholder}" maxlength="50"${list}></div></td>`;
 }
 
 function escapeTagAttr(s: string): string {
@@ -42,6 +44,7 @@ export function createEmptyRow(): HTMLTableRowElement {
     <td data-field="rating">${buildRatingSelect(0)}</td>
     ${buildTagCell("categories", [], "태그 입력...")}
     ${buildTagCell("locations", [], "위치 입력...")}
+    <td class="col-walk"></td>
     <td contenteditable="true" data-field="kakao_url"></td>
     <td contenteditable="true" data-field="description"></td>
     <td class="col-delete"><input type="checkbox" class="row-check"></td>
@@ -62,6 +65,7 @@ export function createMenuRow(): HTMLTableRowElement {
     <td></td>
     <td></td>
     <td></td>
+    <td></td>
     <td contenteditable="true" data-field="menu-description"></td>
     <td class="col-delete"><input type="checkbox" class="menu-check"></td>
   `;
@@ -368,7 +372,12 @@ export function collectPayload(tbody: HTMLTableSectionElement): SavePayload {
     if (status === "new") {
       payload.new.push(readRow(tr));
     } else if (status === "updated") {
-      payload.update.push(readRow(tr));
+      const item = readRow(tr);
+      const original = tr.dataset.originalName;
+      if (original && original !== item.name) {
+        payload.renames = { ...payload.renames, [original]: item.name };
+      }
+      payload.update.push(item);
     } else if (status === "deleted") {
       const name =
         tr.dataset.originalName ||
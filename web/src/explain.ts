@@ -0,0 +1,10 @@
+import type { Explanation } from "./types";
+
+export function formatExplain(explain: Explanation): string {
+  const factors = explain.factors
+    .filter((f) => f.name !== "base")
+    .map((f) => `${f.detail} (${f.value > 0 ? "+" : ""}${f.value.toFixed(1)})`);
+  const probability = (explain.probability * 100).toFixed(1);
+  const head = `[${explain.mode}] 점수 ${explain.score.toFixed(1)}, 확률 ${probability}%`;
+  return factors.length > 0 ? `${head}: ${factors.join(", ")}` : head;
+}
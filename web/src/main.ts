@@ -11,12 +11,14 @@ import {
 } from "./dom";
 import { fetchRecommend, saveBatch } from "./api";
 import { initKeyboardNavigation } from "./navigate";
+import { formatExplain } from "./explain";
 
 const table = document.querySelector<HTMLTableElement>("table")!;
 const tbody = document.querySelector<HTMLTableSectionElement>("#table-body")!;
 const btnAdd = document.querySelector<HTMLButtonElement>("#btn-add")!;
 const btnSave = document.querySelector<HTMLButtonElement>("#btn-save")!;
 const btnRecommend = document.querySelector<HTMLButtonElement>("#btn-recommend")!;
+const recommendReason = document.querySelector<HTMLElement>("#recommend-reason")!;
 
 initKeyboardNavigation(table);
 
@@ -27,6 +29,12 @@ btnRecommend.addEventListener("click", async () => {
       alert("추천할 식당이 없습니다.");
       return;
     }
+    const order = restaurant.order
+      ? ` · 추천 메뉴 ${restaurant.order.name} (${restaurant.order.reason})`
+      : "";
+    recommendReason.textContent = restaurant.explain
+      ? `${restaurant.name}${order} — ${formatExplain(restaurant.explain)}`
+      : "";
     const rows = tbody.querySelectorAll<HTMLTableRowElement>("tr.restaurant-row");
     for (const row of rows) {
       row.classList.remove("row-recommended");
@@ -1,9 +1,25 @@
+export interface PriceChange {
+  date: string;
+  from: number;
+  to: number;
+}
+
+export interface Diet {
+  vegetarian?: boolean;
+  pork_free?: boolean;
+  seafood?: boolean;
+  spicy?: number;
+  allergens?: string[];
+}
+
 export interface Menu {
   name: string;
   rating: number;
   price: number;
   description: string;
   visited: boolean;
+  price_history?: PriceChange[];
+  diet?: Diet;
 }
 
 export interface Restaurant {
@@ -12,13 +28,48 @@ export interface Restaurant {
   categories: string[];
   locations: string[];
   kakao_url: string;
+  address?: string;
+  archived?: boolean;
   visited: boolean;
   description: string;
   menus: Menu[];
+  diet?: Diet;
 }
 
 export interface SavePayload {
   new: Restaurant[];
   update: Restaurant[];
   delete: string[];
+  renames?: Record<string, string>;
+}
+
+export interface Factor {
+  name: string;
+  value: number;
+  detail: string;
+}
+
+export interface Explanation {
+  mode: string;
+  score: number;
+  probability: number;
+  factors: Factor[];
+}
+
+export interface MenuPick extends Menu {
+  score: number;
+  orders: number;
+  reason: string;
+}
+
+export interface PriceRange {
+  min: number;
+  median: number;
+  max: number;
+}
+
+export interface Recommendation extends Restaurant {
+  explain?: Explanation;
+  order?: MenuPick;
+  price_range?: PriceRange;
 }